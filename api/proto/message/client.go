@@ -2,8 +2,8 @@ package message
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"net"
 	"time"
 
 	"google.golang.org/grpc"
@@ -21,7 +21,7 @@ func NewClient(ip string) *Client {
 	client := Client{}
 	client.opts = append(client.opts, grpc.WithInsecure())
 	var err error
-	client.conn, err = grpc.Dial(fmt.Sprintf("%s:%s", ip, Port), client.opts...)
+	client.conn, err = grpc.Dial(net.JoinHostPort(ip, Port), client.opts...)
 	if err != nil {
 		log.Fatalf("fail to dial: %v", err)
 		return nil
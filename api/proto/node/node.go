@@ -71,6 +71,40 @@ var (
 	crossLinkHeartBeatH = []byte{nodeB, blockB, crossLinkHeardBeatB}
 )
 
+// MessageLabel returns the type label a node message is counted under in the
+// p2p metrics, or an empty string for an unknown type. The message starts
+// with the message category byte.
+func MessageLabel(message []byte) string {
+	const typeIndex = proto.MessageCategoryBytes
+	const blockTypeIndex = typeIndex + proto.MessageTypeBytes
+	if len(message) <= typeIndex {
+		return ""
+	}
+	switch MessageType(message[typeIndex]) {
+	case Transaction:
+		return "tx"
+	case Staking:
+		return "staking_tx"
+	case Block:
+		if len(message) <= blockTypeIndex {
+			return ""
+		}
+		switch BlockMessageType(message[blockTypeIndex]) {
+		case Sync:
+			return "block_sync"
+		case SlashCandidate:
+			return "slash"
+		case Receipt:
+			return "node_receipt"
+		case CrossLink:
+			return "crosslink"
+		case CrosslinkHeartbeat:
+			return "crosslink_heartbeat"
+		}
+	}
+	return ""
+}
+
 // ConstructTransactionListMessageAccount constructs serialized transactions in account model
 func ConstructTransactionListMessageAccount(transactions types.Transactions) []byte {
 	byteBuffer := bytes.NewBuffer(transactionListH)
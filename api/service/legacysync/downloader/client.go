@@ -2,7 +2,7 @@ package downloader
 
 import (
 	"context"
-	"fmt"
+	"net"
 	"time"
 
 	pb "github.com/harmony-one/harmony/api/service/legacysync/downloader/proto"
@@ -29,7 +29,7 @@ func ClientSetup(ip, port string, withBlock bool) *Client {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	client.addr = fmt.Sprintf("%s:%s", ip, port)
+	client.addr = net.JoinHostPort(ip, port)
 	var err error
 	client.conn, err = grpc.DialContext(ctx, client.addr, client.opts...)
 	if err != nil {
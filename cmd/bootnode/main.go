@@ -139,7 +139,7 @@ func main() {
 	}
 
 	fmt.Printf("bootnode BN_MA=%s",
-		fmt.Sprintf("/ip4/%s/tcp/%s/p2p/%s", *ip, *port, host.GetID().Pretty()),
+		fmt.Sprintf("%s/p2p/%s", p2p.AddrString(*ip, *port), host.GetID().Pretty()),
 	)
 
 	host.Start()
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/harmony-one/harmony/internal/cli"
 	harmonyconfig "github.com/harmony-one/harmony/internal/configs/harmony"
 	nodeconfig "github.com/harmony-one/harmony/internal/configs/node"
+	"github.com/harmony-one/harmony/p2p"
 	"github.com/pelletier/go-toml"
 	"github.com/spf13/cobra"
 )
@@ -51,11 +53,21 @@ func validateHarmonyConfig(config harmonyconfig.HarmonyConfig) error {
 		return fmt.Errorf("flag --run.offline must have p2p IP be %v", nodeconfig.DefaultLocalListenIP)
 	}
 
+	if _, err := p2p.ListenAddrString(config.P2P.IP, strconv.Itoa(config.P2P.Port)); err != nil {
+		return fmt.Errorf("flag --p2p.ip must be an IP address: %v", config.P2P.IP)
+	}
+
 	if !config.Sync.Downloader && !config.DNSSync.Client {
 		// There is no module up for sync
 		return errors.New("either --sync.downloader or --sync.legacy.client shall be enabled")
 	}
 
+	if config.Consensus != nil {
+		if err := getConsensusTimeouts(*config.Consensus).Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/harmony-one/harmony/api/service/legacysync"
+	"github.com/harmony-one/harmony/consensus"
 	"github.com/harmony-one/harmony/internal/cli"
 	nodeconfig "github.com/harmony-one/harmony/internal/configs/node"
 )
@@ -129,6 +130,11 @@ var (
 	consensusValidFlags = []cli.Flag{
 		consensusMinPeersFlag,
 		consensusAggregateSigFlag,
+		consensusPhaseTimeoutFlag,
+		consensusViewChangeTimeoutFlag,
+		consensusBootstrapTimeoutFlag,
+		consensusRetryIntervalFlag,
+		consensusMaxRetriesFlag,
 		legacyConsensusMinPeersFlag,
 	}
 
@@ -1118,6 +1124,31 @@ var (
 		Usage:    "(multi-key) aggregate bls signatures before sending",
 		DefValue: defaultConsensusConfig.AggregateSig,
 	}
+	consensusPhaseTimeoutFlag = cli.StringFlag{
+		Name:     "consensus.phase-timeout",
+		Usage:    "timeout of a consensus phase before starting a view change, as a golang duration string",
+		DefValue: consensus.DefaultTimeouts().Phase.String(),
+	}
+	consensusViewChangeTimeoutFlag = cli.StringFlag{
+		Name:     "consensus.viewchange-timeout",
+		Usage:    "duration of each view change, as a golang duration string",
+		DefValue: consensus.DefaultTimeouts().ViewChange.String(),
+	}
+	consensusBootstrapTimeoutFlag = cli.StringFlag{
+		Name:     "consensus.bootstrap-timeout",
+		Usage:    "timeout of the first consensus after start up, as a golang duration string",
+		DefValue: consensus.DefaultTimeouts().Bootstrap.String(),
+	}
+	consensusRetryIntervalFlag = cli.StringFlag{
+		Name:     "consensus.retry-interval",
+		Usage:    "interval between resends of a consensus message, as a golang duration string",
+		DefValue: consensus.DefaultTimeouts().RetryInterval.String(),
+	}
+	consensusMaxRetriesFlag = cli.IntFlag{
+		Name:     "consensus.max-retries",
+		Usage:    "number of times a consensus message is resent, 0 to disable",
+		DefValue: consensus.DefaultTimeouts().MaxRetries,
+	}
 	legacyDelayCommitFlag = cli.StringFlag{
 		Name:       "delay_commit",
 		Usage:      "how long to delay sending commit messages in consensus, ex: 500ms, 1s",
@@ -1152,6 +1183,37 @@ func applyConsensusFlags(cmd *cobra.Command, config *harmonyconfig.HarmonyConfig
 	if cli.IsFlagChanged(cmd, consensusAggregateSigFlag) {
 		config.Consensus.AggregateSig = cli.GetBoolFlagValue(cmd, consensusAggregateSigFlag)
 	}
+
+	if cli.IsFlagChanged(cmd, consensusPhaseTimeoutFlag) {
+		config.Consensus.PhaseTimeout = parseConsensusDurationFlag(cmd, consensusPhaseTimeoutFlag)
+	}
+	if cli.IsFlagChanged(cmd, consensusViewChangeTimeoutFlag) {
+		config.Consensus.ViewChangeTimeout = parseConsensusDurationFlag(cmd, consensusViewChangeTimeoutFlag)
+	}
+	if cli.IsFlagChanged(cmd, consensusBootstrapTimeoutFlag) {
+		config.Consensus.BootstrapTimeout = parseConsensusDurationFlag(cmd, consensusBootstrapTimeoutFlag)
+	}
+	if cli.IsFlagChanged(cmd, consensusRetryIntervalFlag) {
+		config.Consensus.RetryInterval = parseConsensusDurationFlag(cmd, consensusRetryIntervalFlag)
+	}
+	if cli.IsFlagChanged(cmd, consensusMaxRetriesFlag) {
+		value := cli.GetIntFlagValue(cmd, consensusMaxRetriesFlag)
+		if value < 0 {
+			panic("Must provide non-negative value for consensus.max-retries")
+		}
+		config.Consensus.MaxRetries = &value
+	}
+}
+
+func parseConsensusDurationFlag(cmd *cobra.Command, flag cli.StringFlag) time.Duration {
+	value, err := time.ParseDuration(cli.GetStringFlagValue(cmd, flag))
+	if err != nil {
+		panic(fmt.Sprintf("Invalid value for %s: %v", flag.Name, err))
+	}
+	if value <= 0 {
+		panic(fmt.Sprintf("Must provide positive value for %s", flag.Name))
+	}
+	return value
 }
 
 // transaction pool flags
@@ -17,6 +17,8 @@ import (
 
 var (
 	trueBool = true
+	fourInt  = 4
+	zeroInt  = 0
 )
 
 func TestHarmonyFlags(t *testing.T) {
@@ -976,6 +978,28 @@ func TestConsensusFlags(t *testing.T) {
 				AggregateSig: true,
 			},
 		},
+		{
+			args: []string{"--consensus.phase-timeout", "10s", "--consensus.viewchange-timeout", "20s",
+				"--consensus.bootstrap-timeout", "1m", "--consensus.retry-interval", "2s",
+				"--consensus.max-retries", "4"},
+			expConfig: &harmonyconfig.ConsensusConfig{
+				MinPeers:          defaultConsensusConfig.MinPeers,
+				AggregateSig:      defaultConsensusConfig.AggregateSig,
+				PhaseTimeout:      10 * time.Second,
+				ViewChangeTimeout: 20 * time.Second,
+				BootstrapTimeout:  time.Minute,
+				RetryInterval:     2 * time.Second,
+				MaxRetries:        &fourInt,
+			},
+		},
+		{
+			args: []string{"--consensus.max-retries", "0"},
+			expConfig: &harmonyconfig.ConsensusConfig{
+				MinPeers:     defaultConsensusConfig.MinPeers,
+				AggregateSig: defaultConsensusConfig.AggregateSig,
+				MaxRetries:   &zeroInt,
+			},
+		},
 	}
 	for i, test := range tests {
 		ts := newFlagTestSuite(t, consensusFlags, applyConsensusFlags)
@@ -384,7 +384,7 @@ func setupNodeAndRun(hc harmonyconfig.HarmonyConfig) {
 		Str("Role", currentNode.NodeConfig.Role().String()).
 		Str("Version", getHarmonyVersion()).
 		Str("multiaddress",
-			fmt.Sprintf("/ip4/%s/tcp/%d/p2p/%s", hc.P2P.IP, hc.P2P.Port, myHost.GetID().Pretty()),
+			fmt.Sprintf("%s/p2p/%s", p2p.AddrString(hc.P2P.IP, strconv.Itoa(hc.P2P.Port)), myHost.GetID().Pretty()),
 		).
 		Msg(startMsg)
 
@@ -659,6 +659,30 @@ func createGlobalConfig(hc harmonyconfig.HarmonyConfig) (*nodeconfig.ConfigType,
 	return nodeConfig, nil
 }
 
+// getConsensusTimeouts returns the consensus timeouts with the ones set in the
+// config overriding the defaults.
+func getConsensusTimeouts(cfg harmonyconfig.ConsensusConfig) consensus.Timeouts {
+	timeouts := consensus.DefaultTimeouts()
+	if cfg.PhaseTimeout > 0 {
+		timeouts.Phase = cfg.PhaseTimeout
+	}
+	if cfg.ViewChangeTimeout > 0 {
+		timeouts.ViewChange = cfg.ViewChangeTimeout
+	}
+	if cfg.BootstrapTimeout > 0 {
+		timeouts.Bootstrap = cfg.BootstrapTimeout
+	}
+	if cfg.RetryInterval > 0 {
+		timeouts.RetryInterval = cfg.RetryInterval
+	}
+	if cfg.MaxRetries != nil {
+		timeouts.MaxRetries = *cfg.MaxRetries
+	} else {
+		timeouts.MaxRetries = consensus.RetriesPerPhase(timeouts.Phase, timeouts.RetryInterval)
+	}
+	return timeouts
+}
+
 func setupConsensusAndNode(hc harmonyconfig.HarmonyConfig, nodeConfig *nodeconfig.ConfigType, registry *registry.Registry) *node.Node {
 	// Parse minPeers from harmonyconfig.HarmonyConfig
 	var minPeers int
@@ -739,6 +763,9 @@ func setupConsensusAndNode(hc harmonyconfig.HarmonyConfig, nodeConfig *nodeconfi
 		_, _ = fmt.Fprintf(os.Stderr, "Error :%v \n", err)
 		os.Exit(1)
 	}
+	if hc.Consensus != nil {
+		currentConsensus.SetTimeouts(getConsensusTimeouts(*hc.Consensus))
+	}
 
 	currentNode := node.New(myHost, currentConsensus, engine, collection, blacklist, allowedTxs, localAccounts, nodeConfig.ArchiveModes(), &hc, registry)
 
@@ -3,10 +3,13 @@ package main
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/harmony-one/harmony/core"
+	harmonyconfig "github.com/harmony-one/harmony/internal/configs/harmony"
+	nodeconfig "github.com/harmony-one/harmony/internal/configs/node"
 )
 
 func TestAllowedTxsParse(t *testing.T) {
@@ -48,3 +51,46 @@ func TestAllowedTxsParse(t *testing.T) {
 		}
 	}
 }
+
+func TestGetConsensusTimeouts(t *testing.T) {
+	timeouts := getConsensusTimeouts(harmonyconfig.ConsensusConfig{RetryInterval: time.Second})
+	if timeouts.MaxRetries != int(timeouts.Phase/time.Second) {
+		t.Errorf("max retries not derived from the retry interval: %v", timeouts.MaxRetries)
+	}
+	retries := 2
+	timeouts = getConsensusTimeouts(harmonyconfig.ConsensusConfig{RetryInterval: time.Second, MaxRetries: &retries})
+	if timeouts.MaxRetries != 2 {
+		t.Errorf("explicit max retries not kept: %v", timeouts.MaxRetries)
+	}
+	retries = 0
+	timeouts = getConsensusTimeouts(harmonyconfig.ConsensusConfig{MaxRetries: &retries})
+	if timeouts.MaxRetries != 0 {
+		t.Errorf("disabled retries not kept: %v", timeouts.MaxRetries)
+	}
+	if err := timeouts.Validate(); err != nil {
+		t.Errorf("disabled retries rejected: %v", err)
+	}
+
+	cfg := getDefaultHmyConfigCopy(nodeconfig.Mainnet)
+	if err := validateHarmonyConfig(cfg); err != nil {
+		t.Fatalf("default config rejected: %v", err)
+	}
+	consensusCfg := getDefaultConsensusConfigCopy()
+	consensusCfg.ViewChangeTimeout = time.Minute
+	cfg.Consensus = &consensusCfg
+	if err := validateHarmonyConfig(cfg); err == nil {
+		t.Error("view change timeout above the view change slot accepted")
+	}
+}
+
+func TestValidateP2PIP(t *testing.T) {
+	cfg := getDefaultHmyConfigCopy(nodeconfig.Mainnet)
+	cfg.P2P.IP = "::"
+	if err := validateHarmonyConfig(cfg); err != nil {
+		t.Errorf("IPv6 p2p ip rejected: %v", err)
+	}
+	cfg.P2P.IP = "harmony-0.svc.cluster.local"
+	if err := validateHarmonyConfig(cfg); err == nil {
+		t.Error("host name accepted as p2p ip to listen on")
+	}
+}
@@ -11,16 +11,24 @@ import (
 	"github.com/harmony-one/harmony/crypto/bls"
 	"github.com/harmony-one/harmony/crypto/hash"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 // MaxBlockNumDiff limits the received block number to only 100 further from the current block number
 const MaxBlockNumDiff = 100
 
+// signedMessageBytes returns the bytes of message covered by its signature
+func signedMessageBytes(message *msg_pb.Message) ([]byte, error) {
+	signature := message.Signature
+	message.Signature = nil
+	defer func() { message.Signature = signature }()
+	return protobuf.Marshal(message)
+}
+
 // verifyMessageSig verify the signature of the message are valid from the signer's public key.
 func verifyMessageSig(signerPubKey *libbls.PublicKey, message *msg_pb.Message) error {
 	signature := message.Signature
-	message.Signature = nil
-	messageBytes, err := protobuf.Marshal(message)
+	messageBytes, err := signedMessageBytes(message)
 	if err != nil {
 		return err
 	}
@@ -34,7 +42,6 @@ func verifyMessageSig(signerPubKey *libbls.PublicKey, message *msg_pb.Message) e
 	if !msgSig.VerifyHash(signerPubKey, msgHash[:]) {
 		return errors.New("failed to verify the signature")
 	}
-	message.Signature = signature
 	return nil
 }
 
@@ -81,12 +88,14 @@ func (consensus *Consensus) onAnnounceSanityChecks(recvMsg *FBFTMessage) bool {
 		}
 		if logMsgs[0].BlockHash != recvMsg.BlockHash &&
 			bytes.Equal(logMsgs[0].SenderPubkeys[0].Bytes[:], recvMsg.SenderPubkeys[0].Bytes[:]) {
-			consensus.getLogger().Debug().
+			consensus.getLogger().Warn().
 				Str("logMsgSenderKey", logMsgs[0].SenderPubkeys[0].Bytes.Hex()).
 				Str("logMsgBlockHash", logMsgs[0].BlockHash.Hex()).
 				Str("recvMsg", recvMsg.String()).
 				Str("LeaderKey", consensus.LeaderPubKey.Bytes.Hex()).
 				Msg("[OnAnnounce] Leader is malicious")
+			consensusCounterVec.With(prometheus.Labels{"consensus": "leader_equivocation"}).Inc()
+			consensus.recordEquivocation(logMsgs[0], recvMsg)
 			if consensus.isViewChangingMode() {
 				consensus.getLogger().Debug().Msg(
 					"[OnAnnounce] Already in ViewChanging mode, conflicing announce, doing noop",
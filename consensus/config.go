@@ -1,6 +1,10 @@
 package consensus
 
-import "time"
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
 
 // timeout constant
 const (
@@ -24,6 +28,69 @@ const (
 	consensusBlockNumBuffer uint64 = 2
 )
 
+// Timeouts holds the durations of the FBFT timers and the consensus message
+// retransmission. They are set per node, but the validators of a shard only
+// converge on a view change if their timers are compatible, so they should be
+// changed for the whole shard at once. The phase and view change timeouts
+// have to stay shorter than viewChangeSlot.
+type Timeouts struct {
+	// Phase is how long announce/prepare/commit may take before a view change
+	Phase time.Duration
+	// ViewChange is the duration of each view change
+	ViewChange time.Duration
+	// Bootstrap is how long to wait for the first block after start up
+	Bootstrap time.Duration
+	// RetryInterval is the delay between two resends of a consensus message
+	RetryInterval time.Duration
+	// MaxRetries is the number of times a consensus message is resent, zero
+	// disables the retries
+	MaxRetries int
+}
+
+// MaxTimeout is the upper bound, exclusive, of the phase and view change
+// timeouts. getNextViewID relies on a view change finishing within one
+// viewChangeSlot.
+const MaxTimeout = viewChangeSlot * time.Second
+
+// DefaultTimeouts returns the timeouts used unless configured otherwise.
+func DefaultTimeouts() Timeouts {
+	phase, retryInterval := phaseDuration, RetryIntervalInSec*time.Second
+	return Timeouts{
+		Phase:         phase,
+		ViewChange:    viewChangeDuration,
+		Bootstrap:     bootstrapDuration,
+		RetryInterval: retryInterval,
+		MaxRetries:    RetriesPerPhase(phase, retryInterval),
+	}
+}
+
+// RetriesPerPhase returns the number of resends of a consensus message that
+// fit into one phase.
+func RetriesPerPhase(phase, retryInterval time.Duration) int {
+	if retryInterval <= 0 {
+		return 0
+	}
+	return int(phase / retryInterval)
+}
+
+// Validate checks that the timeouts are positive and that the phase and view
+// change timeouts are below MaxTimeout.
+func (t Timeouts) Validate() error {
+	if t.Phase <= 0 || t.ViewChange <= 0 || t.Bootstrap <= 0 || t.RetryInterval <= 0 {
+		return errors.New("consensus timeouts must be positive")
+	}
+	if t.Phase >= MaxTimeout {
+		return errors.Errorf("consensus phase timeout %v must be less than %v", t.Phase, MaxTimeout)
+	}
+	if t.ViewChange >= MaxTimeout {
+		return errors.Errorf("consensus view change timeout %v must be less than %v", t.ViewChange, MaxTimeout)
+	}
+	if t.MaxRetries < 0 {
+		return errors.New("consensus max retries must not be negative")
+	}
+	return nil
+}
+
 // TimeoutType is the type of timeout in view change protocol
 type TimeoutType int
 
@@ -54,6 +54,12 @@ type Consensus struct {
 	isBackup bool
 	// 2 types of timeouts: normal and viewchange
 	consensusTimeout map[TimeoutType]*utils.Timeout
+	// durations of the FBFT timers and message retries
+	timeouts Timeouts
+	// latest phase and mode transitions, for debugging
+	transitions *ringBuffer[Transition]
+	// latest conflicting announces of a leader, kept as evidence
+	equivocations *ringBuffer[Equivocation]
 	// Commits collected from validators.
 	aggregatedPrepareSig *bls_core.Sign
 	aggregatedCommitSig  *bls_core.Sign
@@ -126,6 +132,10 @@ type Consensus struct {
 	finality int64
 	// finalityCounter keep tracks of the finality time
 	finalityCounter atomic.Value //int64
+	// phaseStart is when the current FBFT phase was entered
+	phaseStart time.Time
+	// announceTime is when the leader announced the current block
+	announceTime time.Time
 
 	dHelper *downloadHelper
 
@@ -240,6 +250,17 @@ func (consensus *Consensus) SetBlockVerifier(verifier VerifyBlockFunc) {
 	consensus.vc.SetVerifyBlock(consensus.verifyBlock)
 }
 
+// SetTimeouts overrides the FBFT timeouts and the message retry policy.
+// It is meant to be called during setup, before consensus starts.
+func (consensus *Consensus) SetTimeouts(timeouts Timeouts) {
+	consensus.mutex.Lock()
+	defer consensus.mutex.Unlock()
+	consensus.timeouts = timeouts
+	consensus.current.viewChangeDuration = timeouts.ViewChange
+	consensus.consensusTimeout = createTimeout(timeouts)
+	consensus.msgSender.SetRetry(timeouts.MaxRetries, timeouts.RetryInterval)
+}
+
 func (consensus *Consensus) IsBackup() bool {
 	return consensus.isBackup
 }
@@ -270,10 +291,13 @@ func New(
 	consensus.BlockNumLowChan = make(chan struct{}, 1)
 	// FBFT related
 	consensus.FBFTLog = NewFBFTLog()
+	consensus.transitions = newRingBuffer[Transition](transitionLogSize)
+	consensus.equivocations = newRingBuffer[Equivocation](equivocationLogSize)
 	consensus.phase = FBFTAnnounce
-	consensus.current = State{mode: Normal}
+	consensus.current = State{mode: Normal, viewChangeDuration: viewChangeDuration}
 	// FBFT timeout
-	consensus.consensusTimeout = createTimeout()
+	consensus.timeouts = DefaultTimeouts()
+	consensus.consensusTimeout = createTimeout(consensus.timeouts)
 
 	if multiBLSPriKey != nil {
 		consensus.priKey = multiBLSPriKey
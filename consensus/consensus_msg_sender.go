@@ -24,6 +24,8 @@ type MessageSender struct {
 	host p2p.Host
 	// RetryTimes is number of retry attempts
 	retryTimes int
+	// retryInterval is the delay between two retry attempts
+	retryInterval time.Duration
 }
 
 // MessageRetry controls the message that can be retried
@@ -38,7 +40,18 @@ type MessageRetry struct {
 
 // NewMessageSender initializes the consensus message sender.
 func NewMessageSender(host p2p.Host) *MessageSender {
-	return &MessageSender{host: host, retryTimes: int(phaseDuration.Seconds()) / RetryIntervalInSec}
+	timeouts := DefaultTimeouts()
+	return &MessageSender{
+		host:          host,
+		retryTimes:    timeouts.MaxRetries,
+		retryInterval: timeouts.RetryInterval,
+	}
+}
+
+// SetRetry sets the number of retry attempts and the delay between them.
+func (sender *MessageSender) SetRetry(retryTimes int, retryInterval time.Duration) {
+	sender.retryTimes = retryTimes
+	sender.retryInterval = retryInterval
 }
 
 // Reset resets the sender's state for new block
@@ -98,7 +111,7 @@ func (sender *MessageSender) SendWithoutRetry(groups []nodeconfig.GroupID, p2pMs
 // Retry will retry the consensus message for <RetryTimes> times.
 func (sender *MessageSender) Retry(msgRetry *MessageRetry) {
 	for {
-		time.Sleep(RetryIntervalInSec * time.Second)
+		time.Sleep(sender.retryInterval)
 
 		if msgRetry.retryCount >= sender.retryTimes {
 			// Retried enough times
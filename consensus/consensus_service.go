@@ -158,6 +158,7 @@ func (consensus *Consensus) resetState() {
 
 	consensus.blockHash = [32]byte{}
 	consensus.block = []byte{}
+	consensus.announceTime = time.Time{}
 	consensus.Decider.ResetPrepareAndCommitVotes()
 	if consensus.prepareBitmap != nil {
 		consensus.prepareBitmap.Clear()
@@ -196,6 +197,7 @@ func (consensus *Consensus) setMode(m Mode) {
 	consensus.getLogger().Debug().
 		Str("Mode", m.String()).
 		Msg("[SetMode]")
+	consensus.recordTransition(ModeTransition, consensus.current.Mode().String(), m.String(), "")
 	consensus.current.SetMode(m)
 }
 
@@ -551,11 +553,16 @@ func (consensus *Consensus) GetFinality() int64 {
 
 // switchPhase will switch FBFTPhase to desired phase.
 func (consensus *Consensus) switchPhase(subject string, desired FBFTPhase) {
-	consensus.getLogger().Info().
-		Str("from:", consensus.phase.String()).
-		Str("to:", desired.String()).
-		Str("switchPhase:", subject)
-
+	consensus.getLogger().Debug().
+		Str("from", consensus.phase.String()).
+		Str("to", desired.String()).
+		Str("subject", subject).
+		Msg("[switchPhase]")
+
+	consensus.recordTransition(PhaseTransition, consensus.phase.String(), desired.String(), subject)
+	if consensus.phase != desired {
+		consensus.observePhaseDuration()
+	}
 	consensus.phase = desired
 }
 
@@ -588,6 +595,9 @@ func (consensus *Consensus) selfCommit(payload []byte) error {
 
 	copy(consensus.blockHash[:], blockHash[:])
 	consensus.switchPhase("selfCommit", FBFTCommit)
+	// the commit round of the prepared block starts now, there was no announce
+	// of this view to measure the vote latency from
+	consensus.announceTime = time.Now()
 	consensus.aggregatedPrepareSig = aggSig
 	consensus.prepareBitmap = mask
 	commitPayload := signature.ConstructCommitPayload(consensus.Blockchain(),
@@ -21,11 +21,15 @@ func TestConsensusInitialization(t *testing.T) {
 	host, multiBLSPrivateKey, consensus, decider, err := GenerateConsensusForTesting()
 	assert.NoError(t, err)
 
-	messageSender := &MessageSender{host: host, retryTimes: int(phaseDuration.Seconds()) / RetryIntervalInSec}
+	messageSender := &MessageSender{
+		host:          host,
+		retryTimes:    int(phaseDuration.Seconds()) / RetryIntervalInSec,
+		retryInterval: RetryIntervalInSec * time.Second,
+	}
 	fbtLog := NewFBFTLog()
 	state := State{mode: Normal}
 
-	timeouts := createTimeout()
+	timeouts := createTimeout(DefaultTimeouts())
 	expectedTimeouts := make(map[TimeoutType]time.Duration)
 	expectedTimeouts[timeoutConsensus] = phaseDuration
 	expectedTimeouts[timeoutViewChange] = viewChangeDuration
@@ -76,6 +80,51 @@ func TestConsensusInitialization(t *testing.T) {
 	assert.NotNil(t, consensus.IgnoreViewIDCheck)
 }
 
+func TestSetTimeouts(t *testing.T) {
+	_, _, consensus, _, err := GenerateConsensusForTesting()
+	assert.NoError(t, err)
+
+	timeouts := Timeouts{
+		Phase:         5 * time.Second,
+		ViewChange:    10 * time.Second,
+		Bootstrap:     30 * time.Second,
+		RetryInterval: time.Second,
+		MaxRetries:    2,
+	}
+	consensus.SetTimeouts(timeouts)
+
+	assert.Equal(t, timeouts.Phase, consensus.consensusTimeout[timeoutConsensus].Duration())
+	assert.Equal(t, timeouts.ViewChange, consensus.consensusTimeout[timeoutViewChange].Duration())
+	assert.Equal(t, timeouts.Bootstrap, consensus.consensusTimeout[timeoutBootstrap].Duration())
+	assert.Equal(t, timeouts.MaxRetries, consensus.msgSender.retryTimes)
+	assert.Equal(t, timeouts.RetryInterval, consensus.msgSender.retryInterval)
+
+	_, duration := consensus.fallbackNextViewID()
+	assert.Equal(t, timeouts.ViewChange, duration)
+
+	consensus.current.SetViewChangingID(consensus.current.GetCurBlockViewID() + 2)
+	assert.Equal(t, 4*timeouts.ViewChange, consensus.current.GetViewChangeDuraion())
+}
+
+func TestTimeoutsValidate(t *testing.T) {
+	assert.NoError(t, DefaultTimeouts().Validate())
+
+	timeouts := DefaultTimeouts()
+	timeouts.ViewChange = MaxTimeout
+	assert.Error(t, timeouts.Validate())
+
+	timeouts = DefaultTimeouts()
+	timeouts.Phase = time.Minute
+	assert.Error(t, timeouts.Validate())
+
+	timeouts = DefaultTimeouts()
+	timeouts.RetryInterval = 0
+	assert.Error(t, timeouts.Validate())
+
+	assert.Equal(t, 27, RetriesPerPhase(27*time.Second, time.Second))
+	assert.Equal(t, DefaultTimeouts().MaxRetries, RetriesPerPhase(phaseDuration, RetryIntervalInSec*time.Second))
+}
+
 // GenerateConsensusForTesting - helper method to generate a basic consensus
 func GenerateConsensusForTesting() (p2p.Host, multibls.PrivateKeys, *Consensus, quorum.Decider, error) {
 	hostData := helpers.Hosts[0]
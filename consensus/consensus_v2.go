@@ -99,6 +99,7 @@ func (consensus *Consensus) HandleMessageUpdate(ctx context.Context, msg *msg_pb
 	if err != nil || fbftMsg == nil {
 		return errors.Wrapf(err, "unable to parse consensus msg with type: %s", msg.Type)
 	}
+	consensusMsgCounterVec.With(prometheus.Labels{"type": msg.Type.String()}).Inc()
 
 	canHandleViewChange := true
 	intendedForValidator, intendedForLeader :=
@@ -346,7 +347,7 @@ func (consensus *Consensus) syncReadyChan() {
 		consensus.setBlockNum(consensus.Blockchain().CurrentHeader().Number().Uint64() + 1)
 		consensus.setViewIDs(consensus.Blockchain().CurrentHeader().ViewID().Uint64() + 1)
 		mode := consensus.updateConsensusInformation()
-		consensus.current.SetMode(mode)
+		consensus.setMode(mode)
 		consensus.getLogger().Info().Msg("[syncReadyChan] Start consensus timer")
 		consensus.consensusTimeout[timeoutConsensus].Start()
 		consensus.getLogger().Info().Str("Mode", mode.String()).Msg("Node is IN SYNC")
@@ -365,7 +366,7 @@ func (consensus *Consensus) syncReadyChan() {
 func (consensus *Consensus) syncNotReadyChan() {
 	consensus.getLogger().Info().Msg("[ConsensusMainLoop] syncNotReadyChan")
 	consensus.setBlockNum(consensus.Blockchain().CurrentHeader().Number().Uint64() + 1)
-	consensus.current.SetMode(Syncing)
+	consensus.setMode(Syncing)
 	consensus.getLogger().Info().Msg("[ConsensusMainLoop] Node is OUT OF SYNC")
 	consensusSyncCounterVec.With(prometheus.Labels{"consensus": "out_of_sync"}).Inc()
 }
@@ -799,7 +800,7 @@ func (consensus *Consensus) postCatchup(initBN uint64) {
 	}
 	// catch up and skip from view change trap
 	if initBN < consensus.getBlockNum() && consensus.isViewChangingMode() {
-		consensus.current.SetMode(Normal)
+		consensus.setMode(Normal)
 		consensus.consensusTimeout[timeoutViewChange].Stop()
 	}
 }
@@ -109,7 +109,7 @@ func (consensus *Consensus) AddConsensusLastMile() error {
 func (consensus *Consensus) spinUpStateSync() {
 	if consensus.dHelper != nil {
 		consensus.dHelper.d.DownloadAsync()
-		consensus.current.SetMode(Syncing)
+		consensus.setMode(Syncing)
 		for _, v := range consensus.consensusTimeout {
 			v.Stop()
 		}
@@ -121,7 +121,7 @@ func (consensus *Consensus) spinUpStateSync() {
 func (consensus *Consensus) spinLegacyStateSync() {
 	select {
 	case consensus.BlockNumLowChan <- struct{}{}:
-		consensus.current.SetMode(Syncing)
+		consensus.setMode(Syncing)
 		for _, v := range consensus.consensusTimeout {
 			v.Stop()
 		}
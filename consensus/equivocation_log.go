@@ -0,0 +1,57 @@
+package consensus
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/harmony-one/harmony/crypto/bls"
+)
+
+// equivocationLogSize is the number of most recent leader equivocations kept
+const equivocationLogSize = 16
+
+// Equivocation is the evidence of a leader announcing two different blocks for
+// the same block number and view ID. The signed envelopes of both announces
+// are kept, so anyone holding the leader key can check the evidence.
+type Equivocation struct {
+	Time      time.Time
+	BlockNum  uint64
+	ViewID    uint64
+	LeaderKey bls.SerializedPublicKey
+	First     *FBFTMessage
+	Second    *FBFTMessage
+}
+
+// BlockHashes returns the hashes of the two conflicting announced blocks
+func (e Equivocation) BlockHashes() [2]common.Hash {
+	return [2]common.Hash{e.First.BlockHash, e.Second.BlockHash}
+}
+
+// sameAs tells whether e is about the same conflicting announces as other,
+// in either order
+func (e Equivocation) sameAs(other Equivocation) bool {
+	if e.BlockNum != other.BlockNum || e.ViewID != other.ViewID || e.LeaderKey != other.LeaderKey {
+		return false
+	}
+	a, b := e.BlockHashes(), other.BlockHashes()
+	return a == b || a[0] == b[1] && a[1] == b[0]
+}
+
+// recordEquivocation keeps the two conflicting announces of the same leader.
+// A conflicting announce sent again, as the leader retries it, is recorded once.
+func (consensus *Consensus) recordEquivocation(first, second *FBFTMessage) {
+	e := Equivocation{
+		Time:      time.Now(),
+		BlockNum:  second.BlockNum,
+		ViewID:    second.ViewID,
+		LeaderKey: second.SenderPubkeys[0].Bytes,
+		First:     first,
+		Second:    second,
+	}
+	consensus.equivocations.addUnless(e, e.sameAs)
+}
+
+// GetEquivocations returns the latest leader equivocations, oldest first.
+func (consensus *Consensus) GetEquivocations() []Equivocation {
+	return consensus.equivocations.list()
+}
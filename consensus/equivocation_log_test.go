@@ -0,0 +1,57 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	msg_pb "github.com/harmony-one/harmony/api/proto/message"
+	"github.com/harmony-one/harmony/crypto/bls"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnnounceEquivocationRecorded(t *testing.T) {
+	_, multiBLSPrivateKey, consensus, _, err := GenerateConsensusForTesting()
+	require.NoError(t, err)
+	leader := multiBLSPrivateKey[0].Pub
+	consensus.LeaderPubKey = leader
+	// already view changing, so the conflicting announce does not start one
+	consensus.setMode(ViewChanging)
+
+	announce := func(hash common.Hash) *FBFTMessage {
+		return &FBFTMessage{
+			MessageType:   msg_pb.MessageType_ANNOUNCE,
+			BlockNum:      consensus.getBlockNum(),
+			ViewID:        consensus.getCurBlockViewID(),
+			BlockHash:     hash,
+			SenderPubkeys: []*bls.PublicKeyWrapper{leader},
+			SignedMessage: hash[:],
+			Signature:     []byte{hash[0]},
+		}
+	}
+	first := announce(common.Hash{1})
+	consensus.FBFTLog.AddVerifiedMessage(first)
+
+	// the same announce again is no evidence
+	assert.True(t, consensus.onAnnounceSanityChecks(announce(common.Hash{1})))
+	assert.Empty(t, consensus.GetEquivocations())
+
+	second := announce(common.Hash{2})
+	consensus.onAnnounceSanityChecks(second)
+	equivocations := consensus.GetEquivocations()
+	require.Len(t, equivocations, 1)
+	assert.Equal(t, first.BlockNum, equivocations[0].BlockNum)
+	assert.Equal(t, first.ViewID, equivocations[0].ViewID)
+	assert.Equal(t, leader.Bytes, equivocations[0].LeaderKey)
+	assert.Equal(t, [2]common.Hash{{1}, {2}}, equivocations[0].BlockHashes())
+	assert.Same(t, first, equivocations[0].First)
+	assert.Same(t, second, equivocations[0].Second)
+	assert.Equal(t, []byte{2}, equivocations[0].Second.Signature)
+
+	// the leader retrying the conflicting announce is no new evidence
+	consensus.onAnnounceSanityChecks(announce(common.Hash{2}))
+	assert.Len(t, consensus.GetEquivocations(), 1)
+
+	consensus.onAnnounceSanityChecks(announce(common.Hash{3}))
+	assert.Len(t, consensus.GetEquivocations(), 2)
+}
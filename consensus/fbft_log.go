@@ -33,6 +33,10 @@ type FBFTMessage struct {
 	M3AggSig           *bls_core.Sign
 	M3Bitmap           *bls_cosi.Mask
 	Verified           bool
+	// SignedMessage and Signature are the signed envelope of an announce,
+	// kept as evidence in case the leader announces a conflicting block
+	SignedMessage []byte
+	Signature     []byte
 }
 
 // String ..
@@ -28,6 +28,7 @@ func (consensus *Consensus) announce(block *types.Block) {
 
 	copy(consensus.blockHash[:], blockHash[:])
 	consensus.block = encodedBlock // Must set block bytes before consensus.construct()
+	consensus.announceTime = time.Now()
 
 	key, err := consensus.getConsensusLeaderPrivateKey()
 	if err != nil {
@@ -171,6 +172,7 @@ func (consensus *Consensus) onPrepare(recvMsg *FBFTMessage) {
 		consensus.getLogger().Warn().Err(err).Msg("submit vote prepare failed")
 		return
 	}
+	consensus.observeVoteLatency(quorum.Prepare, recvMsg.SenderPubkeys)
 	// Set the bitmap indicating that this validator signed.
 	if err := prepareBitmap.SetKeysAtomic(recvMsg.SenderPubkeys, true); err != nil {
 		consensus.getLogger().Warn().Err(err).Msg("[OnPrepare] prepareBitmap.SetKey failed")
@@ -271,6 +273,7 @@ func (consensus *Consensus) onCommit(recvMsg *FBFTMessage) {
 	); err != nil {
 		return
 	}
+	consensus.observeVoteLatency(quorum.Commit, recvMsg.SenderPubkeys)
 	// Set the bitmap indicating that this validator signed.
 	if err := commitBitmap.SetKeysAtomic(recvMsg.SenderPubkeys, true); err != nil {
 		consensus.getLogger().Warn().Err(err).
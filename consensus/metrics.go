@@ -3,8 +3,11 @@ package consensus
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	prom "github.com/harmony-one/harmony/api/service/prometheus"
+	"github.com/harmony-one/harmony/consensus/quorum"
+	"github.com/harmony-one/harmony/crypto/bls"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -81,6 +84,47 @@ var (
 			Buckets:   prometheus.ExponentialBuckets(800, 1.25, 10),
 		},
 	)
+	// consensusPhaseHistogram is used to keep track of the time spent in each
+	// FBFT phase, in the unit of millisecond:
+	// 100, 200, 400, 800, 1600, 3200, 6400, 12800, inf
+	consensusPhaseHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "hmy",
+			Subsystem: "consensus",
+			Name:      "phase_duration",
+			Help:      "the time spent in each consensus phase",
+			Buckets:   prometheus.ExponentialBuckets(100, 2, 8),
+		},
+		[]string{
+			"phase",
+		},
+	)
+	// consensusVoteLatencyHistogram is used to keep track of how long after the
+	// announce the leader receives the votes of the validators, in millisecond
+	consensusVoteLatencyHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "hmy",
+			Subsystem: "consensus",
+			Name:      "vote_latency",
+			Help:      "the latency of the validator votes since the announce",
+			Buckets:   prometheus.ExponentialBuckets(100, 2, 8),
+		},
+		[]string{
+			"phase",
+		},
+	)
+	// consensusMsgCounterVec is used to keep track of received consensus messages
+	consensusMsgCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "hmy",
+			Subsystem: "consensus",
+			Name:      "messages",
+			Help:      "counter of received consensus messages",
+		},
+		[]string{
+			"type",
+		},
+	)
 
 	onceMetrics sync.Once
 
@@ -113,6 +157,34 @@ func (consensus *Consensus) AddPubkeyMetrics() {
 	}
 }
 
+// observePhaseDuration records the time spent in the phase being left
+func (consensus *Consensus) observePhaseDuration() {
+	now := time.Now()
+	if !consensus.phaseStart.IsZero() {
+		consensusPhaseHistogram.With(prometheus.Labels{"phase": consensus.phase.String()}).
+			Observe(float64(now.Sub(consensus.phaseStart).Milliseconds()))
+	}
+	consensus.phaseStart = now
+}
+
+// observeVoteLatency records how long after the announce the vote of signers
+// was received. The latency of each validator is logged to find slow ones.
+func (consensus *Consensus) observeVoteLatency(phase quorum.Phase, signers []*bls.PublicKeyWrapper) {
+	if consensus.announceTime.IsZero() {
+		return
+	}
+	latency := time.Since(consensus.announceTime)
+	consensusVoteLatencyHistogram.With(prometheus.Labels{"phase": phase.String()}).
+		Observe(float64(latency.Milliseconds()))
+	for _, signer := range signers {
+		consensus.getLogger().Debug().
+			Str("phase", phase.String()).
+			Str("validatorPubKey", signer.Bytes.Hex()).
+			Int64("latencyMs", latency.Milliseconds()).
+			Msg("[VoteLatency]")
+	}
+}
+
 func initMetrics() {
 	onceMetrics.Do(func() {
 		prom.PromRegistry().MustRegister(
@@ -122,6 +194,9 @@ func initMetrics() {
 			consensusGaugeVec,
 			consensusPubkeyVec,
 			consensusFinalityHistogram,
+			consensusPhaseHistogram,
+			consensusVoteLatencyHistogram,
+			consensusMsgCounterVec,
 		)
 	})
 }
@@ -0,0 +1,61 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/harmony-one/harmony/consensus/quorum"
+	"github.com/harmony-one/harmony/crypto/bls"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sampleCount returns the number of observations of the histogram with the
+// given phase label
+func sampleCount(t *testing.T, vec *prometheus.HistogramVec, phase string) uint64 {
+	metric := &dto.Metric{}
+	observer := vec.With(prometheus.Labels{"phase": phase}).(prometheus.Histogram)
+	require.NoError(t, observer.Write(metric))
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func TestObservePhaseDuration(t *testing.T) {
+	_, _, consensus, _, err := GenerateConsensusForTesting()
+	require.NoError(t, err)
+
+	// the time spent before the first phase switch is unknown
+	consensus.switchPhase("test", FBFTPrepare)
+	assert.False(t, consensus.phaseStart.IsZero())
+
+	before := sampleCount(t, consensusPhaseHistogram, FBFTPrepare.String())
+	consensus.switchPhase("test", FBFTCommit)
+	assert.Equal(t, before+1, sampleCount(t, consensusPhaseHistogram, FBFTPrepare.String()))
+
+	// switching to the same phase is not a transition
+	before = sampleCount(t, consensusPhaseHistogram, FBFTCommit.String())
+	consensus.switchPhase("test", FBFTCommit)
+	assert.Equal(t, before, sampleCount(t, consensusPhaseHistogram, FBFTCommit.String()))
+}
+
+func TestObserveVoteLatency(t *testing.T) {
+	_, multiBLSPrivateKey, consensus, _, err := GenerateConsensusForTesting()
+	require.NoError(t, err)
+	signers := []*bls.PublicKeyWrapper{multiBLSPrivateKey[0].Pub}
+
+	// no announce yet, nothing is observed
+	before := sampleCount(t, consensusVoteLatencyHistogram, quorum.Prepare.String())
+	consensus.observeVoteLatency(quorum.Prepare, signers)
+	assert.Equal(t, before, sampleCount(t, consensusVoteLatencyHistogram, quorum.Prepare.String()))
+
+	consensus.announceTime = time.Now()
+	consensus.observeVoteLatency(quorum.Prepare, signers)
+	assert.Equal(t, before+1, sampleCount(t, consensusVoteLatencyHistogram, quorum.Prepare.String()))
+
+	// the announce of the previous round must not be used for the votes of the next one
+	consensus.resetState()
+	assert.True(t, consensus.announceTime.IsZero())
+	consensus.observeVoteLatency(quorum.Prepare, signers)
+	assert.Equal(t, before+1, sampleCount(t, consensusVoteLatencyHistogram, quorum.Prepare.String()))
+}
@@ -0,0 +1,53 @@
+package consensus
+
+import "sync"
+
+// ringBuffer is a fixed size, thread safe buffer of the latest entries
+type ringBuffer[T any] struct {
+	mutex   sync.Mutex
+	entries []T
+	next    int
+}
+
+func newRingBuffer[T any](size int) *ringBuffer[T] {
+	return &ringBuffer[T]{entries: make([]T, 0, size)}
+}
+
+// add adds the entry, overwriting the oldest one once the buffer is full
+func (buf *ringBuffer[T]) add(entry T) {
+	buf.mutex.Lock()
+	defer buf.mutex.Unlock()
+	buf.push(entry)
+}
+
+// addUnless adds the entry unless a kept entry matches dup, and returns
+// whether it was added
+func (buf *ringBuffer[T]) addUnless(entry T, dup func(T) bool) bool {
+	buf.mutex.Lock()
+	defer buf.mutex.Unlock()
+	for _, e := range buf.entries {
+		if dup(e) {
+			return false
+		}
+	}
+	buf.push(entry)
+	return true
+}
+
+func (buf *ringBuffer[T]) push(entry T) {
+	if len(buf.entries) < cap(buf.entries) {
+		buf.entries = append(buf.entries, entry)
+		return
+	}
+	buf.entries[buf.next] = entry
+	buf.next = (buf.next + 1) % len(buf.entries)
+}
+
+// list returns a copy of the kept entries, oldest first
+func (buf *ringBuffer[T]) list() []T {
+	buf.mutex.Lock()
+	defer buf.mutex.Unlock()
+	res := make([]T, 0, len(buf.entries))
+	res = append(res, buf.entries[buf.next:]...)
+	return append(res, buf.entries[:buf.next]...)
+}
@@ -0,0 +1,22 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRingBuffer(t *testing.T) {
+	buf := newRingBuffer[int](3)
+	assert.Empty(t, buf.list())
+
+	for i := 1; i <= 5; i++ {
+		buf.add(i)
+	}
+	assert.Equal(t, []int{3, 4, 5}, buf.list())
+
+	isFour := func(e int) bool { return e == 4 }
+	assert.False(t, buf.addUnless(4, isFour))
+	assert.True(t, buf.addUnless(6, func(e int) bool { return e == 6 }))
+	assert.Equal(t, []int{4, 5, 6}, buf.list())
+}
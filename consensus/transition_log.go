@@ -0,0 +1,51 @@
+package consensus
+
+import (
+	"time"
+)
+
+// transitionLogSize is the number of most recent transitions kept
+const transitionLogSize = 256
+
+// TransitionKind tells which part of the consensus state changed
+type TransitionKind string
+
+// Kinds of consensus state transitions
+const (
+	PhaseTransition TransitionKind = "phase"
+	ModeTransition  TransitionKind = "mode"
+)
+
+// Transition is one change of the FBFT phase or of the consensus mode, kept
+// to find out where a stuck round stopped.
+type Transition struct {
+	Time     time.Time
+	Kind     TransitionKind
+	From     string
+	To       string
+	Subject  string
+	BlockNum uint64
+	ViewID   uint64
+}
+
+// recordTransition adds a transition of the given kind to the log, stamped with
+// the current block number and view ID. Noop transitions are not recorded.
+func (consensus *Consensus) recordTransition(kind TransitionKind, from, to, subject string) {
+	if from == to {
+		return
+	}
+	consensus.transitions.add(Transition{
+		Time:     time.Now(),
+		Kind:     kind,
+		From:     from,
+		To:       to,
+		Subject:  subject,
+		BlockNum: consensus.getBlockNum(),
+		ViewID:   consensus.getCurBlockViewID(),
+	})
+}
+
+// GetTransitions returns the latest phase and mode transitions, oldest first.
+func (consensus *Consensus) GetTransitions() []Transition {
+	return consensus.transitions.list()
+}
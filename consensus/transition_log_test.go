@@ -0,0 +1,26 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordTransition(t *testing.T) {
+	_, _, consensus, _, err := GenerateConsensusForTesting()
+	assert.NoError(t, err)
+
+	consensus.switchPhase("test", FBFTAnnounce)
+	assert.Empty(t, consensus.GetTransitions())
+
+	consensus.switchPhase("test", FBFTPrepare)
+	consensus.setMode(ViewChanging)
+	transitions := consensus.GetTransitions()
+	assert.Len(t, transitions, 2)
+	assert.Equal(t, PhaseTransition, transitions[0].Kind)
+	assert.Equal(t, FBFTAnnounce.String(), transitions[0].From)
+	assert.Equal(t, FBFTPrepare.String(), transitions[0].To)
+	assert.Equal(t, "test", transitions[0].Subject)
+	assert.Equal(t, ModeTransition, transitions[1].Kind)
+	assert.Equal(t, ViewChanging.String(), transitions[1].To)
+}
@@ -27,6 +27,15 @@ func (consensus *Consensus) onAnnounce(msg *msg_pb.Message) {
 		return
 	}
 
+	if recvMsg.SignedMessage, err = signedMessageBytes(msg); err != nil {
+		consensus.getLogger().Error().
+			Err(err).
+			Uint64("MsgBlockNum", recvMsg.BlockNum).
+			Msg("[OnAnnounce] Unable to encode leader message")
+		return
+	}
+	recvMsg.Signature = msg.Signature
+
 	// NOTE let it handle its own logs
 	if !consensus.onAnnounceSanityChecks(recvMsg) {
 		return
@@ -35,6 +35,9 @@ type State struct {
 	viewChangingID uint64
 
 	isBackup bool
+
+	// viewChangeDuration is the duration of one view change
+	viewChangeDuration time.Duration
 }
 
 // Mode return the current node mode
@@ -78,7 +81,7 @@ func (pm *State) SetViewChangingID(id uint64) {
 // It increase in the power of difference betweeen view changing ID and current view ID
 func (pm *State) GetViewChangeDuraion() time.Duration {
 	diff := int64(pm.viewChangingID - pm.blockViewID)
-	return time.Duration(diff * diff * int64(viewChangeDuration))
+	return time.Duration(diff * diff * int64(pm.viewChangeDuration))
 }
 
 func (pm *State) SetIsBackup(isBackup bool) {
@@ -95,7 +98,7 @@ func (consensus *Consensus) fallbackNextViewID() (uint64, time.Duration) {
 	consensus.getLogger().Error().
 		Int64("diff", diff).
 		Msg("[fallbackNextViewID] use legacy viewID algorithm")
-	return consensus.getViewChangingID() + 1, time.Duration(diff * diff * int64(viewChangeDuration))
+	return consensus.getViewChangingID() + 1, time.Duration(diff * diff * int64(consensus.timeouts.ViewChange))
 }
 
 // getNextViewID return the next view ID based on the timestamp
@@ -142,7 +145,7 @@ func (consensus *Consensus) getNextViewID() (uint64, time.Duration) {
 		Msg("[getNextViewID]")
 
 	// duration is always the fixed view change duration for synchronous view change
-	return nextViewID, viewChangeDuration
+	return nextViewID, consensus.timeouts.ViewChange
 }
 
 // getNextLeaderKey uniquely determine who is the leader for given viewID
@@ -230,11 +233,11 @@ func (consensus *Consensus) getNextLeaderKey(viewID uint64) *bls.PublicKeyWrappe
 	return next
 }
 
-func createTimeout() map[TimeoutType]*utils.Timeout {
+func createTimeout(durations Timeouts) map[TimeoutType]*utils.Timeout {
 	timeouts := make(map[TimeoutType]*utils.Timeout)
-	timeouts[timeoutConsensus] = utils.NewTimeout(phaseDuration)
-	timeouts[timeoutViewChange] = utils.NewTimeout(viewChangeDuration)
-	timeouts[timeoutBootstrap] = utils.NewTimeout(bootstrapDuration)
+	timeouts[timeoutConsensus] = utils.NewTimeout(durations.Phase)
+	timeouts[timeoutViewChange] = utils.NewTimeout(durations.ViewChange)
+	timeouts[timeoutBootstrap] = utils.NewTimeout(durations.Bootstrap)
 	return timeouts
 }
 
@@ -246,7 +249,7 @@ func (consensus *Consensus) startViewChange() {
 
 	consensus.consensusTimeout[timeoutConsensus].Stop()
 	consensus.consensusTimeout[timeoutBootstrap].Stop()
-	consensus.current.SetMode(ViewChanging)
+	consensus.setMode(ViewChanging)
 	nextViewID, duration := consensus.getNextViewID()
 	consensus.setViewChangingID(nextViewID)
 	// TODO: set the Leader PubKey to the next leader for view change
@@ -331,7 +334,7 @@ func (consensus *Consensus) startNewView(viewID uint64, newLeaderPriKey *bls.Pri
 
 	consensus.msgSender.StopRetry(msg_pb.MessageType_VIEWCHANGE)
 
-	consensus.current.SetMode(Normal)
+	consensus.setMode(Normal)
 	consensus.consensusTimeout[timeoutViewChange].Stop()
 	consensus.setViewIDs(viewID)
 	consensus.resetViewChangeState()
@@ -565,7 +568,7 @@ func (consensus *Consensus) resetViewChangeState() {
 	consensus.getLogger().Info().
 		Str("Phase", consensus.phase.String()).
 		Msg("[ResetViewChangeState] Resetting view change state")
-	consensus.current.SetMode(Normal)
+	consensus.setMode(Normal)
 	consensus.vc.Reset()
 	consensus.Decider.ResetViewChangeVotes()
 }
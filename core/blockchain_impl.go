@@ -1638,8 +1638,8 @@ func (bc *BlockChainImpl) insertChain(chain types.Blocks, verifyHeaders bool) (i
 		defer close(abort)
 	}
 
-	// Start a parallel signature recovery (signer will fluke on fork transition, minimal perf loss)
-	//senderCacher.recoverFromBlocks(types.MakeSigner(bc.chainConfig, chain[0].Number()), chain)
+	// Start a parallel signature recovery
+	senderCacher.recoverFromBlocks(bc.chainConfig, chain)
 
 	// Iterate over the blocks and insert when the verifier permits
 	for i, block := range chain {
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	blockfactory "github.com/harmony-one/harmony/block/factory"
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/internal/params"
+)
+
+func TestRecoverFromBlocks(t *testing.T) {
+	config := params.TestChainConfig
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	ethSigner := types.NewEIP155Signer(config.EthCompatibleChainID)
+
+	var blocks []*types.Block
+	for _, epoch := range []int64{0, 10} {
+		signer := types.MakeSigner(config, big.NewInt(epoch))
+		txs := make(types.Transactions, 0, 20)
+		for i := uint64(0); i < 10; i++ {
+			tx, err := types.SignTx(
+				types.NewTransaction(i, common.Address{}, 0, big.NewInt(1), 21000, big.NewInt(1), nil),
+				signer, key,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ethTx, err := types.SignEthTx(
+				types.NewEthTransaction(i, common.Address{}, big.NewInt(1), 21000, big.NewInt(1), nil),
+				ethSigner, key,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			txs = append(txs, tx, ethTx.ConvertToHmy())
+		}
+		header := blockfactory.NewTestHeader().With().Epoch(big.NewInt(epoch)).Header()
+		blocks = append(blocks, types.NewBlock(header, txs, nil, nil, nil, nil))
+	}
+
+	senderCacher.recoverFromBlocks(config, blocks)
+
+	for _, block := range blocks {
+		for i, tx := range block.Transactions() {
+			deadline := time.Now().Add(5 * time.Second)
+			for tx.From().Load() == nil {
+				if time.Now().After(deadline) {
+					t.Fatalf("epoch %v tx %d: sender was not cached", block.Epoch(), i)
+				}
+				time.Sleep(time.Millisecond)
+			}
+			cached := tx.From().Load()
+
+			// the signer the state processor applies the transaction with
+			signer := types.MakeSigner(config, block.Epoch())
+			if tx.IsEthCompatible() {
+				signer = ethSigner
+			}
+			sender, err := types.Sender(signer, tx)
+			if err != nil {
+				t.Fatalf("epoch %v tx %d: Sender() error = %v", block.Epoch(), i, err)
+			}
+			if sender != from {
+				t.Errorf("epoch %v tx %d: sender = %s, want %s", block.Epoch(), i, sender.Hex(), from.Hex())
+			}
+			// types.Sender only stores a new cache entry on a signer mismatch
+			if tx.From().Load() != cached {
+				t.Errorf("epoch %v tx %d: sender was cached with a different signer", block.Epoch(), i)
+			}
+		}
+	}
+}
@@ -31,6 +31,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/harmony-one/harmony/block"
 	blockfactory "github.com/harmony-one/harmony/block/factory"
@@ -598,6 +600,87 @@ func (b *Block) Hash() common.Hash {
 	return v
 }
 
+// findTx returns the position in the tx trie of the transaction or staking
+// transaction with the given hash, and its harmony format hash, which is the
+// hash of the trie leaf. Ethereum compatible transactions are also matched on
+// their eth hash. Staking transactions are counted after plain transactions.
+func (b *Block) findTx(txHash common.Hash) (uint, common.Hash, bool) {
+	index := uint(0)
+	for _, tx := range b.transactions {
+		if tx.Hash() == txHash || tx.HashByType() == txHash {
+			return index, tx.Hash(), true
+		}
+		index++
+	}
+	for _, tx := range b.stakingTransactions {
+		if tx.Hash() == txHash {
+			return index, tx.Hash(), true
+		}
+		index++
+	}
+	return 0, common.Hash{}, false
+}
+
+// TxProof returns the position of the transaction or staking transaction with
+// the given hash in the block, and the merkle proof of its inclusion under the
+// header TxHash. Ethereum compatible transactions can be looked up by either
+// their harmony or their eth hash. Staking transactions are counted after
+// plain transactions.
+func (b *Block) TxProof(txHash common.Hash) (uint, *memorydb.Database, error) {
+	index, _, proof, err := b.txProof(txHash)
+	return index, proof, err
+}
+
+// txProof is TxProof, also returning the hash of the proven trie leaf
+func (b *Block) txProof(txHash common.Hash) (uint, common.Hash, *memorydb.Database, error) {
+	index, leafHash, found := b.findTx(txHash)
+	if !found {
+		return 0, common.Hash{}, nil, errors.Errorf("transaction %x not found in block", txHash)
+	}
+	proof := memorydb.New()
+	if err := DeriveShaProof(
+		index, proof,
+		Transactions(b.transactions),
+		staking.StakingTransactions(b.stakingTransactions),
+	); err != nil {
+		return 0, common.Hash{}, nil, errors.Wrap(err, "cannot build transaction proof")
+	}
+	return index, leafHash, proof, nil
+}
+
+// VerifyMerklePath checks that the transaction with the given harmony or eth
+// hash is committed to by the TxHash of the block header.
+func (b *Block) VerifyMerklePath(txHash common.Hash) error {
+	index, leafHash, proof, err := b.txProof(txHash)
+	if err != nil {
+		return err
+	}
+	return VerifyTxProof(b.TxHash(), leafHash, index, proof)
+}
+
+// VerifyTxProof checks that proof, as returned by Block.TxProof, shows the
+// transaction with the given hash at the given index under txRoot. Only the
+// header is needed, so light clients can verify inclusion without the body.
+// The trie holds transactions in harmony format, so txHash must be the
+// harmony hash (Transaction.Hash), also for ethereum compatible transactions.
+func VerifyTxProof(
+	txRoot, txHash common.Hash, index uint, proof ethdb.KeyValueReader,
+) error {
+	value, err := VerifyShaProof(txRoot, index, proof)
+	if err != nil {
+		return errors.Wrap(err, "invalid transaction proof")
+	}
+	if value == nil {
+		return errors.Errorf("no transaction at index %d", index)
+	}
+	if got := hash.Keccak256Hash(value); got != txHash {
+		return errors.Errorf(
+			"transaction at index %d has hash %x, expected %x", index, got, txHash,
+		)
+	}
+	return nil
+}
+
 // Blocks is an array of Block.
 type Blocks []*Block
 
@@ -19,9 +19,12 @@ package types
 
 import (
 	"bytes"
+	"math/big"
 	"reflect"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/harmony-one/taggedrlp"
 
@@ -31,6 +34,7 @@ import (
 	v0 "github.com/harmony-one/harmony/block/v0"
 	v1 "github.com/harmony-one/harmony/block/v1"
 	v2 "github.com/harmony-one/harmony/block/v2"
+	"github.com/harmony-one/harmony/internal/params"
 )
 
 var (
@@ -1065,3 +1069,85 @@ func TestBody_DecodeRLP(t *testing.T) {
 		})
 	}
 }
+
+func TestBlock_TxProof(t *testing.T) {
+	txs := make(Transactions, 20)
+	for i := range txs {
+		txs[i] = NewTransaction(
+			uint64(i), common.Address{}, 0, big.NewInt(1), 21000, big.NewInt(1), nil,
+		)
+	}
+	header := blockfactory.NewTestHeader()
+	header.SetTxHash(DeriveSha(txs))
+	b := NewBlockWithHeader(header).WithBody(txs, nil, nil, nil)
+
+	for i, tx := range txs {
+		index, proof, err := b.TxProof(tx.Hash())
+		if err != nil {
+			t.Fatalf("tx %d: TxProof() error = %v", i, err)
+		}
+		if index != uint(i) {
+			t.Errorf("tx %d: TxProof() index = %d", i, index)
+		}
+		if err := VerifyTxProof(header.TxHash(), tx.Hash(), index, proof); err != nil {
+			t.Errorf("tx %d: VerifyTxProof() error = %v", i, err)
+		}
+		if err := b.VerifyMerklePath(tx.Hash()); err != nil {
+			t.Errorf("tx %d: VerifyMerklePath() error = %v", i, err)
+		}
+	}
+
+	index, proof, err := b.TxProof(txs[3].Hash())
+	if err != nil {
+		t.Fatalf("TxProof() error = %v", err)
+	}
+	if err := VerifyTxProof(header.TxHash(), txs[4].Hash(), index, proof); err == nil {
+		t.Error("VerifyTxProof() accepted proof for a different transaction")
+	}
+	if err := VerifyTxProof(EmptyRootHash, txs[3].Hash(), index, proof); err == nil {
+		t.Error("VerifyTxProof() accepted proof against a different root")
+	}
+	if _, _, err := b.TxProof(common.Hash{0x01}); err == nil {
+		t.Error("TxProof() found a transaction not in the block")
+	}
+}
+
+func TestBlock_TxProofEthCompatible(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	signer := NewEIP155Signer(params.TestChainConfig.EthCompatibleChainID)
+	txs := make(Transactions, 3)
+	for i := range txs {
+		ethTx, err := SignEthTx(
+			NewEthTransaction(uint64(i), common.Address{}, big.NewInt(1), 21000, big.NewInt(1), nil),
+			signer, key,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		txs[i] = ethTx.ConvertToHmy()
+	}
+	header := blockfactory.NewTestHeader()
+	header.SetTxHash(DeriveSha(txs))
+	b := NewBlockWithHeader(header).WithBody(txs, nil, nil, nil)
+
+	for i, tx := range txs {
+		if tx.Hash() == tx.HashByType() {
+			t.Fatalf("tx %d: eth hash equals harmony hash", i)
+		}
+		for _, txHash := range []common.Hash{tx.Hash(), tx.HashByType()} {
+			index, proof, err := b.TxProof(txHash)
+			if err != nil {
+				t.Fatalf("tx %d: TxProof(%x) error = %v", i, txHash, err)
+			}
+			if index != uint(i) {
+				t.Errorf("tx %d: TxProof(%x) index = %d", i, txHash, index)
+			}
+			if err := VerifyTxProof(header.TxHash(), tx.Hash(), index, proof); err != nil {
+				t.Errorf("tx %d: VerifyTxProof() error = %v", i, err)
+			}
+			if err := b.VerifyMerklePath(txHash); err != nil {
+				t.Errorf("tx %d: VerifyMerklePath(%x) error = %v", i, txHash, err)
+			}
+		}
+	}
+}
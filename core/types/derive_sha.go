@@ -23,6 +23,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
 )
@@ -42,19 +43,39 @@ type DerivableList interface {
 
 // DeriveSha calculates the hash of the trie generated by DerivableList.
 func DeriveSha(list ...DerivableBase) common.Hash {
-	keybuf := new(bytes.Buffer)
+	return deriveTrie(list...).Hash()
+}
+
+// DeriveShaProof writes into proofDb the merkle proof of the element at the
+// given index, counted across all the lists, under the root of DeriveSha.
+func DeriveShaProof(index uint, proofDb ethdb.KeyValueWriter, list ...DerivableBase) error {
+	return deriveTrie(list...).Prove(deriveShaKey(index), 0, proofDb)
+}
+
+// VerifyShaProof checks the proof produced by DeriveShaProof against root and
+// returns the RLP encoding of the proven element. A nil value with nil error
+// means the proof shows there is no element at the given index.
+func VerifyShaProof(root common.Hash, index uint, proofDb ethdb.KeyValueReader) ([]byte, error) {
+	return trie.VerifyProof(root, deriveShaKey(index), proofDb)
+}
+
+func deriveTrie(list ...DerivableBase) *trie.Trie {
 	trie := new(trie.Trie)
 	var num uint
 
 	for j := range list {
 		for i := 0; i < list[j].Len(); i++ {
-			keybuf.Reset()
-			rlp.Encode(keybuf, num)
-			trie.Update(keybuf.Bytes(), list[j].GetRlp(i))
+			trie.Update(deriveShaKey(num), list[j].GetRlp(i))
 			num++
 		}
 	}
-	return trie.Hash()
+	return trie
+}
+
+func deriveShaKey(index uint) []byte {
+	keybuf := new(bytes.Buffer)
+	rlp.Encode(keybuf, index)
+	return keybuf.Bytes()
 }
 
 //// Legacy forked logic. Keep as is, but do not use it anymore ->
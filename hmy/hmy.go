@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
@@ -107,6 +108,8 @@ type NodeAPI interface {
 	ListPeer(topic string) []peer.ID
 	ListTopic() []string
 	ListBlockedPeer() []peer.ID
+	ListPeerLatency() map[peer.ID]time.Duration
+	ListPeerFaults() map[peer.ID]int
 
 	GetConsensusInternal() commonRPC.ConsensusInternal
 	IsBackup() bool
@@ -117,6 +120,8 @@ type NodeAPI interface {
 	GetConsensusPhase() string
 	GetConsensusViewChangingID() uint64
 	GetConsensusCurViewID() uint64
+	GetConsensusTransitions() []commonRPC.ConsensusTransition
+	GetLeaderEquivocations() []commonRPC.LeaderEquivocation
 	GetConfig() commonRPC.Config
 	ShutDown()
 	GetLastSigningPower() (float64, error)
@@ -25,9 +25,16 @@ func (hmy *Harmony) GetPeerInfo() commonRPC.NodePeerInfo {
 		copy(p[i].Peers, topicPeer)
 	}
 
+	latency := map[peer.ID]int64{}
+	for id, rtt := range hmy.NodeAPI.ListPeerLatency() {
+		latency[id] = rtt.Milliseconds()
+	}
+
 	return commonRPC.NodePeerInfo{
 		PeerID:       nodeconfig.GetPeerID(),
 		BlockedPeers: hmy.NodeAPI.ListBlockedPeer(),
 		P:            p,
+		Latency:      latency,
+		Faults:       hmy.NodeAPI.ListPeerFaults(),
 	}
 }
@@ -160,6 +160,15 @@ type ShardDataConfig struct {
 type ConsensusConfig struct {
 	MinPeers     int
 	AggregateSig bool
+
+	// FBFT timeouts and message retries, zero values use the consensus defaults
+	PhaseTimeout      time.Duration `toml:",omitempty"`
+	ViewChangeTimeout time.Duration `toml:",omitempty"`
+	BootstrapTimeout  time.Duration `toml:",omitempty"`
+	RetryInterval     time.Duration `toml:",omitempty"`
+	// MaxRetries is derived from the phase timeout and retry interval when
+	// not set, zero disables the retries
+	MaxRetries *int `toml:",omitempty"`
 }
 
 type BlsConfig struct {
@@ -1,6 +1,10 @@
 package node
 
 import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/harmony-one/harmony/consensus"
 	"github.com/harmony-one/harmony/consensus/quorum"
 	"github.com/harmony-one/harmony/core/types"
 	"github.com/harmony-one/harmony/eth/rpc"
@@ -38,6 +42,16 @@ func (node *Node) ListBlockedPeer() []peer.ID {
 	return node.host.ListBlockedPeer()
 }
 
+// ListPeerFaults return the recent number of malformed messages per peer
+func (node *Node) ListPeerFaults() map[peer.ID]int {
+	return node.host.ListPeerFaults()
+}
+
+// ListPeerLatency return the average RTT of the connected peers
+func (node *Node) ListPeerLatency() map[peer.ID]time.Duration {
+	return node.host.ListPeerLatency()
+}
+
 // PendingCXReceipts returns node.pendingCXReceiptsProof
 func (node *Node) PendingCXReceipts() []*types.CXReceiptsProof {
 	cxReceipts := make([]*types.CXReceiptsProof, len(node.pendingCXReceipts))
@@ -149,6 +163,48 @@ func (node *Node) GetConsensusInternal() rpc_common.ConsensusInternal {
 	}
 }
 
+// GetConsensusTransitions returns the latest consensus phase and mode transitions
+func (node *Node) GetConsensusTransitions() []rpc_common.ConsensusTransition {
+	transitions := node.Consensus.GetTransitions()
+	res := make([]rpc_common.ConsensusTransition, 0, len(transitions))
+	for _, t := range transitions {
+		res = append(res, rpc_common.ConsensusTransition{
+			Time:     t.Time.UnixMilli(),
+			Kind:     string(t.Kind),
+			From:     t.From,
+			To:       t.To,
+			Subject:  t.Subject,
+			BlockNum: t.BlockNum,
+			ViewID:   t.ViewID,
+		})
+	}
+	return res
+}
+
+// GetLeaderEquivocations returns the latest conflicting announces of the leaders
+func (node *Node) GetLeaderEquivocations() []rpc_common.LeaderEquivocation {
+	equivocations := node.Consensus.GetEquivocations()
+	res := make([]rpc_common.LeaderEquivocation, 0, len(equivocations))
+	for _, e := range equivocations {
+		announces := make([]rpc_common.SignedAnnounce, 0, 2)
+		for _, msg := range []*consensus.FBFTMessage{e.First, e.Second} {
+			announces = append(announces, rpc_common.SignedAnnounce{
+				BlockHash: msg.BlockHash.Hex(),
+				Message:   hexutil.Encode(msg.SignedMessage),
+				Signature: hexutil.Encode(msg.Signature),
+			})
+		}
+		res = append(res, rpc_common.LeaderEquivocation{
+			Time:      e.Time.UnixMilli(),
+			BlockNum:  e.BlockNum,
+			ViewID:    e.ViewID,
+			LeaderKey: e.LeaderKey.Hex(),
+			Announces: announces,
+		})
+	}
+	return res
+}
+
 // IsBackup returns the node is in backup mode
 func (node *Node) IsBackup() bool {
 	return node.Consensus.IsBackup()
@@ -31,6 +31,18 @@ var (
 			"type",
 		},
 	)
+	// nodeP2PMessageBytesCounterVec is used to keep track of the size of p2p messages received
+	nodeP2PMessageBytesCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "hmy",
+			Subsystem: "p2p",
+			Name:      "message_bytes",
+			Help:      "number of bytes of p2p messages",
+		},
+		[]string{
+			"type",
+		},
+	)
 	// nodeConsensusMessageCounterVec is used to keep track of consensus p2p messages received
 	nodeConsensusMessageCounterVec = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -78,6 +90,7 @@ func initMetrics() {
 		prom.PromRegistry().MustRegister(
 			nodeStringCounterVec,
 			nodeP2PMessageCounterVec,
+			nodeP2PMessageBytesCounterVec,
 			nodeConsensusMessageCounterVec,
 			nodeNodeMessageCounterVec,
 			nodeCrossLinkMessageCounterVec,
@@ -763,11 +763,12 @@ func (node *Node) StartPubSub() error {
 			func(ctx context.Context, peer libp2p_peer.ID, msg *libp2p_pubsub.Message) libp2p_pubsub.ValidationResult {
 				nodeP2PMessageCounterVec.With(prometheus.Labels{"type": "total"}).Inc()
 				hmyMsg := msg.GetData()
+				nodeP2PMessageBytesCounterVec.With(prometheus.Labels{"type": "total"}).Add(float64(len(hmyMsg)))
 
 				// first to validate the size of the p2p message
 				if len(hmyMsg) < p2pMsgPrefixSize {
-					// TODO (lc): block peers sending empty messages
 					nodeP2PMessageCounterVec.With(prometheus.Labels{"type": "invalid_size"}).Inc()
+					node.host.ReportPeerFault(msg.ReceivedFrom)
 					return libp2p_pubsub.ValidationReject
 				}
 
@@ -779,12 +780,14 @@ func (node *Node) StartPubSub() error {
 					// received consensus message in non-consensus bound topic
 					if !isConsensusBound {
 						nodeP2PMessageCounterVec.With(prometheus.Labels{"type": "invalid_bound"}).Inc()
+						node.host.ReportPeerFault(msg.ReceivedFrom)
 						errChan <- withError{
 							errors.WithStack(errConsensusMessageOnUnexpectedTopic), msg,
 						}
 						return libp2p_pubsub.ValidationReject
 					}
 					nodeP2PMessageCounterVec.With(prometheus.Labels{"type": "consensus_total"}).Inc()
+					nodeP2PMessageBytesCounterVec.With(prometheus.Labels{"type": "consensus_total"}).Add(float64(len(hmyMsg)))
 
 					// validate consensus message
 					validMsg, senderPubKey, ignore, err := validateShardBoundMessage(
@@ -813,9 +816,14 @@ func (node *Node) StartPubSub() error {
 					// node message is almost empty
 					if len(openBox) <= p2pNodeMsgPrefixSize {
 						nodeP2PMessageCounterVec.With(prometheus.Labels{"type": "invalid_size"}).Inc()
+						node.host.ReportPeerFault(msg.ReceivedFrom)
 						return libp2p_pubsub.ValidationReject
 					}
 					nodeP2PMessageCounterVec.With(prometheus.Labels{"type": "node_total"}).Inc()
+					nodeP2PMessageBytesCounterVec.With(prometheus.Labels{"type": "node_total"}).Add(float64(len(hmyMsg)))
+					if label := proto_node.MessageLabel(openBox); label != "" {
+						nodeP2PMessageBytesCounterVec.With(prometheus.Labels{"type": label}).Add(float64(len(hmyMsg)))
+					}
 					validMsg, actionType, err := node.validateNodeMessage(
 						context.TODO(), openBox,
 					)
@@ -839,7 +847,8 @@ func (node *Node) StartPubSub() error {
 					}
 					return libp2p_pubsub.ValidationAccept
 				default:
-					// ignore garbled messages
+					// ignore garbled messages, without scoring the peer as the
+					// category may be new to this version
 					nodeP2PMessageCounterVec.With(prometheus.Labels{"type": "ignored"}).Inc()
 					return libp2p_pubsub.ValidationReject
 				}
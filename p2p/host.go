@@ -64,11 +64,17 @@ type Host interface {
 	ListPeer(topic string) []libp2p_peer.ID
 	ListTopic() []string
 	ListBlockedPeer() []libp2p_peer.ID
+	// ListPeerLatency returns the average RTT of the connected peers
+	ListPeerLatency() map[libp2p_peer.ID]time.Duration
+	// ReportPeerFault records a malformed message received from the peer
+	ReportPeerFault(id libp2p_peer.ID)
+	// ListPeerFaults returns the recent number of malformed messages per peer
+	ListPeerFaults() map[libp2p_peer.ID]int
 }
 
 // Peer is the object for a p2p peer (node)
 type Peer struct {
-	IP              string         // IP address of the peer
+	IP              string         // IP address or host name of the peer
 	Port            string         // Port number of the peer
 	ConsensusPubKey *bls.PublicKey // Public key of the peer, used for consensus signing
 	Addrs           []ma.Multiaddr // MultiAddress of the peer
@@ -123,7 +129,10 @@ func NewHost(cfg HostConfig) (Host, error) {
 		key  = cfg.BLSKey
 	)
 
-	addr := fmt.Sprintf("/ip4/%s/tcp/%s", self.IP, self.Port)
+	addr, err := ListenAddrString(self.IP, self.Port)
+	if err != nil {
+		return nil, err
+	}
 	listenAddr := libp2p.ListenAddrStrings(
 		addr,         // regular tcp connections
 		addr+"/quic", // a UDP endpoint for the QUIC transport
@@ -210,6 +219,9 @@ func NewHost(cfg HostConfig) (Host, error) {
 		return nil, errors.Wrap(err, "cannot create DHT discovery")
 	}
 
+	// the peer scores are the pubsub blacklist, so bans expire in both
+	scores := newPeerScores()
+
 	options := []libp2p_pubsub.Option{
 		// WithValidateQueueSize sets the buffer of validate queue. Defaults to 32. When queue is full, validation is throttled and new messages are dropped.
 		libp2p_pubsub.WithValidateQueueSize(512),
@@ -221,6 +233,7 @@ func NewHost(cfg HostConfig) (Host, error) {
 		libp2p_pubsub.WithValidateThrottle(MaxMessageHandlers),
 		libp2p_pubsub.WithMaxMessageSize(MaxMessageSize),
 		libp2p_pubsub.WithDiscovery(disc.GetRawDiscovery()),
+		libp2p_pubsub.WithBlacklist(scores),
 	}
 
 	traceFile := os.Getenv("P2P_TRACEFILE")
@@ -264,6 +277,7 @@ func NewHost(cfg HostConfig) (Host, error) {
 		priKey:        key,
 		discovery:     disc,
 		security:      security,
+		scores:        scores,
 		onConnections: ConnectCallbacks{},
 		onDisconnects: DisconnectCallbacks{},
 		logger:        &subLogger,
@@ -318,7 +332,7 @@ type HostV2 struct {
 	discovery     discovery.Discovery
 	security      security.Security
 	logger        *zerolog.Logger
-	blocklist     libp2p_pubsub.Blacklist
+	scores        *peerScores
 	onConnections ConnectCallbacks
 	onDisconnects DisconnectCallbacks
 	ctx           context.Context
@@ -339,6 +353,7 @@ func (host *HostV2) Start() error {
 	for _, proto := range host.streamProtos {
 		proto.Start()
 	}
+	go host.probePeers()
 	return host.discovery.Start()
 }
 
@@ -415,6 +430,7 @@ func (host *HostV2) SendMessageToGroups(groups []nodeconfig.GroupID, msg []byte)
 			err = e
 			continue
 		}
+		countPublishedMessage(msg)
 	}
 
 	return err
@@ -434,7 +450,7 @@ func (host *HostV2) AddPeer(p *Peer) error {
 
 	// reconstruct the multiaddress based on ip/port
 	// PeerID has to be known for the ip/port
-	addr := fmt.Sprintf("/ip4/%s/tcp/%s", p.IP, p.Port)
+	addr := AddrString(p.IP, p.Port)
 	targetAddr, err := ma.NewMultiaddr(addr)
 	if err != nil {
 		host.logger.Error().Err(err).Msg("AddPeer NewMultiaddr error")
@@ -492,9 +508,35 @@ func (host *HostV2) ListPeer(topic string) []libp2p_peer.ID {
 
 // ListBlockedPeer returns list of blocked peer
 func (host *HostV2) ListBlockedPeer() []libp2p_peer.ID {
-	// TODO: this is a place holder for now
-	peers := make([]libp2p_peer.ID, 0)
-	return peers
+	return host.scores.listBlocked()
+}
+
+// ListPeerFaults returns the number of malformed messages each peer sent in
+// its current fault window
+func (host *HostV2) ListPeerFaults() map[libp2p_peer.ID]int {
+	return host.scores.listFaults()
+}
+
+// ReportPeerFault records a malformed message received from the peer. Once
+// the peer sent more than maxPeerFaults of them within peerFaultWindow, it is
+// blacklisted from pubsub and disconnected for peerBanDuration. Messages
+// published by the host itself are never scored.
+func (host *HostV2) ReportPeerFault(id libp2p_peer.ID) {
+	if id == host.h.ID() {
+		return
+	}
+	faults, blocked := host.scores.addFault(id)
+	if !blocked {
+		return
+	}
+	host.logger.Warn().
+		Str("peer", id.String()).
+		Int("faults", faults).
+		Msg("blocking peer sending malformed messages")
+	host.pubsub.BlacklistPeer(id)
+	if err := host.h.Network().ClosePeer(id); err != nil {
+		host.logger.Warn().Err(err).Str("peer", id.String()).Msg("failed to disconnect blocked peer")
+	}
 }
 
 // GetPeerCount ...
@@ -505,7 +547,7 @@ func (host *HostV2) GetPeerCount() int {
 // ConnectHostPeer connects to peer host
 func (host *HostV2) ConnectHostPeer(peer Peer) error {
 	ctx := context.Background()
-	addr := fmt.Sprintf("/ip4/%s/tcp/%s/ipfs/%s", peer.IP, peer.Port, peer.PeerID.Pretty())
+	addr := fmt.Sprintf("%s/ipfs/%s", AddrString(peer.IP, peer.Port), peer.PeerID.Pretty())
 	peerAddr, err := ma.NewMultiaddr(addr)
 	if err != nil {
 		host.logger.Error().Err(err).Interface("peer", peer).Msg("ConnectHostPeer")
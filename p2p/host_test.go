@@ -0,0 +1,70 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	harmony_bls "github.com/harmony-one/harmony/crypto/bls"
+	libp2p_crypto "github.com/libp2p/go-libp2p/core/crypto"
+	libp2p_peer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHost(t *testing.T) Host {
+	key, _, err := libp2p_crypto.GenerateKeyPair(libp2p_crypto.RSA, 2048)
+	require.NoError(t, err)
+	self := Peer{
+		IP:              "127.0.0.1",
+		Port:            "0",
+		ConsensusPubKey: harmony_bls.RandPrivateKey().GetPublicKey(),
+	}
+	host, err := NewHost(HostConfig{Self: &self, BLSKey: key})
+	require.NoError(t, err)
+	t.Cleanup(func() { host.Close() })
+	return host
+}
+
+func TestReportPeerFault(t *testing.T) {
+	host := newTestHost(t)
+	bad := libp2p_peer.ID("bad")
+
+	for i := 0; i < maxPeerFaults; i++ {
+		host.ReportPeerFault(bad)
+	}
+	require.Empty(t, host.ListBlockedPeer())
+
+	require.Equal(t, map[libp2p_peer.ID]int{bad: maxPeerFaults}, host.ListPeerFaults())
+
+	host.ReportPeerFault(bad)
+	require.Equal(t, []libp2p_peer.ID{bad}, host.ListBlockedPeer())
+	require.Empty(t, host.ListPeerFaults())
+}
+
+func TestReportPeerFaultBanExpires(t *testing.T) {
+	host := newTestHost(t)
+	// the scores are the pubsub blacklist of the host
+	scores := host.(*HostV2).scores
+	now := time.Now()
+	scores.now = func() time.Time { return now }
+	bad := libp2p_peer.ID("bad")
+
+	for i := 0; i <= maxPeerFaults; i++ {
+		host.ReportPeerFault(bad)
+	}
+	require.True(t, scores.Contains(bad))
+
+	// pubsub accepts the peer again once the ban expired
+	now = now.Add(peerBanDuration)
+	require.False(t, scores.Contains(bad))
+	require.Empty(t, host.ListBlockedPeer())
+}
+
+func TestReportPeerFaultSelf(t *testing.T) {
+	host := newTestHost(t)
+
+	// messages published by the host itself are validated as received from it
+	for i := 0; i <= maxPeerFaults; i++ {
+		host.ReportPeerFault(host.GetID())
+	}
+	require.Empty(t, host.ListBlockedPeer())
+}
@@ -3,7 +3,11 @@ package p2p
 
 import (
 	eth_metrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/harmony-one/harmony/api/proto"
+	proto_node "github.com/harmony-one/harmony/api/proto/node"
+	prom "github.com/harmony-one/harmony/api/service/prometheus"
 	"github.com/libp2p/go-libp2p/core/metrics"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 const (
@@ -17,8 +21,70 @@ const (
 var (
 	ingressTrafficMeter = eth_metrics.NewRegisteredMeter(ingressMeterName, nil)
 	egressTrafficMeter  = eth_metrics.NewRegisteredMeter(egressMeterName, nil)
+
+	// sentMessageCounterVec is used to keep track of the messages published,
+	// with the same type labels as the received message counters of the node
+	sentMessageCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "hmy",
+			Subsystem: "p2p",
+			Name:      "sent_message",
+			Help:      "number of p2p messages published",
+		},
+		[]string{
+			"type",
+		},
+	)
+	// sentBytesCounterVec is used to keep track of the bytes published, with
+	// the same type labels as the received message bytes counter of the node
+	sentBytesCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "hmy",
+			Subsystem: "p2p",
+			Name:      "sent_bytes",
+			Help:      "number of bytes of p2p messages published",
+		},
+		[]string{
+			"type",
+		},
+	)
 )
 
+func init() {
+	prom.PromRegistry().MustRegister(
+		sentMessageCounterVec,
+		sentBytesCounterVec,
+	)
+}
+
+// messageTypes returns the type labels a message built with ConstructMessage
+// is counted under besides the total: its category, and the node message type
+// for node messages
+func messageTypes(msg []byte) []string {
+	if len(msg) <= 5 {
+		return nil
+	}
+	switch proto.MessageCategory(msg[5]) {
+	case proto.Consensus:
+		return []string{"consensus_total"}
+	case proto.Node:
+		if label := proto_node.MessageLabel(msg[5:]); label != "" {
+			return []string{"node_total", label}
+		}
+		return []string{"node_total"}
+	}
+	return nil
+}
+
+// countPublishedMessage accounts a message published to one group
+func countPublishedMessage(msg []byte) {
+	size := float64(len(msg))
+	for _, typ := range append([]string{"total"}, messageTypes(msg)...) {
+		sentMessageCounterVec.With(prometheus.Labels{"type": typ}).Inc()
+		sentBytesCounterVec.With(prometheus.Labels{"type": typ}).Add(size)
+	}
+}
+
 // Counter is a wrapper around a metrics.BandwidthCounter that meters both the
 // inbound and outbound network traffic.
 type Counter struct {
@@ -0,0 +1,29 @@
+package p2p
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/harmony-one/harmony/api/proto"
+	proto_node "github.com/harmony-one/harmony/api/proto/node"
+	"github.com/harmony-one/harmony/core/types"
+)
+
+func TestMessageTypes(t *testing.T) {
+	tests := []struct {
+		msg []byte
+		exp []string
+	}{
+		{ConstructMessage(proto.ConstructConsensusMessage([]byte{1, 2})), []string{"consensus_total"}},
+		{ConstructMessage(proto_node.ConstructTransactionListMessageAccount(nil)), []string{"node_total", "tx"}},
+		{ConstructMessage(proto_node.ConstructBlocksSyncMessage([]*types.Block{})), []string{"node_total", "block_sync"}},
+		{ConstructMessage([]byte{byte(proto.Node), 0xff}), []string{"node_total"}},
+		{ConstructMessage([]byte{byte(proto.DRand)}), nil},
+		{ConstructMessage(nil), nil},
+	}
+	for i, test := range tests {
+		if got := messageTypes(test.msg); !reflect.DeepEqual(got, test.exp) {
+			t.Errorf("Test %v: unexpected types %v / %v", i, got, test.exp)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package p2p
+
+import (
+	"sync"
+	"time"
+
+	libp2p_peer "github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// maxPeerFaults is the number of malformed messages a peer may send within
+	// peerFaultWindow before it gets blocked
+	maxPeerFaults = 20
+	// peerFaultWindow is the time after which the faults of a peer are forgotten
+	peerFaultWindow = time.Minute
+	// peerBanDuration is how long a peer stays blocked
+	peerBanDuration = time.Hour
+	// maxTrackedPeers bounds the number of peers faults and bans are kept for
+	maxTrackedPeers = 4096
+)
+
+type peerFaults struct {
+	count int
+	since time.Time
+}
+
+// peerScores counts the faults of each peer in a time window and keeps the
+// peers blocked for sending more than maxPeerFaults malformed messages in it.
+// It is also the pubsub blacklist, so a ban ends for pubsub when it expires
+// here.
+type peerScores struct {
+	lock    sync.RWMutex
+	faults  map[libp2p_peer.ID]*peerFaults
+	blocked map[libp2p_peer.ID]time.Time
+	now     func() time.Time
+}
+
+func newPeerScores() *peerScores {
+	return &peerScores{
+		faults:  make(map[libp2p_peer.ID]*peerFaults),
+		blocked: make(map[libp2p_peer.ID]time.Time),
+		now:     time.Now,
+	}
+}
+
+// addFault records a fault of the peer. It returns the number of faults of
+// the peer in the current window, and true if the peer is to be blocked
+// because of it.
+func (s *peerScores) addFault(id libp2p_peer.ID) (int, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	if until, ok := s.blocked[id]; ok {
+		if now.Before(until) {
+			return 0, false
+		}
+		delete(s.blocked, id)
+	}
+	f, ok := s.faults[id]
+	if !ok {
+		if s.tracked() >= maxTrackedPeers {
+			s.prune(now)
+		}
+		if s.tracked() >= maxTrackedPeers {
+			// too many faulty peers at once, the newcomers are not tracked
+			// until the window of the others expires
+			return 0, false
+		}
+		f = &peerFaults{since: now}
+		s.faults[id] = f
+	} else if now.Sub(f.since) > peerFaultWindow {
+		f.count, f.since = 0, now
+	}
+	f.count++
+	if f.count <= maxPeerFaults {
+		return f.count, false
+	}
+	delete(s.faults, id)
+	s.blocked[id] = now.Add(peerBanDuration)
+	return f.count, true
+}
+
+// Add blocks the peer for peerBanDuration, implementing pubsub.Blacklist. It
+// returns false if the peer is already blocked.
+func (s *peerScores) Add(id libp2p_peer.ID) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	if until, ok := s.blocked[id]; ok && now.Before(until) {
+		return false
+	}
+	delete(s.faults, id)
+	s.blocked[id] = now.Add(peerBanDuration)
+	return true
+}
+
+// Contains tells whether the peer is blocked, implementing pubsub.Blacklist
+func (s *peerScores) Contains(id libp2p_peer.ID) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	until, ok := s.blocked[id]
+	return ok && s.now().Before(until)
+}
+
+// tracked returns the number of peers with faults or bans
+func (s *peerScores) tracked() int {
+	return len(s.faults) + len(s.blocked)
+}
+
+// prune drops the expired fault windows and bans
+func (s *peerScores) prune(now time.Time) {
+	for id, f := range s.faults {
+		if now.Sub(f.since) > peerFaultWindow {
+			delete(s.faults, id)
+		}
+	}
+	for id, until := range s.blocked {
+		if !now.Before(until) {
+			delete(s.blocked, id)
+		}
+	}
+}
+
+// listBlocked returns the blocked peers
+func (s *peerScores) listBlocked() []libp2p_peer.ID {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.prune(s.now())
+	peers := make([]libp2p_peer.ID, 0, len(s.blocked))
+	for id := range s.blocked {
+		peers = append(peers, id)
+	}
+	return peers
+}
+
+// listFaults returns the number of faults of the peers in their current window
+func (s *peerScores) listFaults() map[libp2p_peer.ID]int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.prune(s.now())
+	faults := make(map[libp2p_peer.ID]int, len(s.faults))
+	for id, f := range s.faults {
+		faults[id] = f.count
+	}
+	return faults
+}
@@ -0,0 +1,96 @@
+package p2p
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	libp2p_pubsub "github.com/libp2p/go-libp2p-pubsub"
+	libp2p_peer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+var _ libp2p_pubsub.Blacklist = &peerScores{}
+
+// newTestPeerScores returns peer scores on a clock moved by the returned func
+func newTestPeerScores() (*peerScores, func(time.Duration)) {
+	scores := newPeerScores()
+	now := time.Now()
+	scores.now = func() time.Time { return now }
+	return scores, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestPeerScores(t *testing.T) {
+	scores, advance := newTestPeerScores()
+	bad, good := libp2p_peer.ID("bad"), libp2p_peer.ID("good")
+
+	for i := 1; i <= maxPeerFaults; i++ {
+		faults, blocked := scores.addFault(bad)
+		require.Equal(t, i, faults)
+		require.False(t, blocked)
+	}
+	_, blocked := scores.addFault(good)
+	require.False(t, blocked)
+	require.Empty(t, scores.listBlocked())
+	require.Equal(t, map[libp2p_peer.ID]int{bad: maxPeerFaults, good: 1}, scores.listFaults())
+
+	faults, blocked := scores.addFault(bad)
+	require.Equal(t, maxPeerFaults+1, faults)
+	require.True(t, blocked)
+	require.True(t, scores.Contains(bad))
+	require.False(t, scores.Contains(good))
+	_, blocked = scores.addFault(bad)
+	require.False(t, blocked)
+	require.Equal(t, []libp2p_peer.ID{bad}, scores.listBlocked())
+
+	// the ban expires
+	advance(peerBanDuration)
+	require.False(t, scores.Contains(bad))
+	require.Empty(t, scores.listBlocked())
+	faults, blocked = scores.addFault(bad)
+	require.Equal(t, 1, faults)
+	require.False(t, blocked)
+}
+
+func TestPeerScoresBlacklist(t *testing.T) {
+	scores, advance := newTestPeerScores()
+	id := libp2p_peer.ID("peer")
+
+	require.True(t, scores.Add(id))
+	require.False(t, scores.Add(id))
+	require.True(t, scores.Contains(id))
+	require.Equal(t, []libp2p_peer.ID{id}, scores.listBlocked())
+
+	advance(peerBanDuration)
+	require.False(t, scores.Contains(id))
+	require.True(t, scores.Add(id))
+}
+
+func TestPeerScoresWindow(t *testing.T) {
+	scores, advance := newTestPeerScores()
+	id := libp2p_peer.ID("peer")
+
+	for i := 0; i < maxPeerFaults; i++ {
+		scores.addFault(id)
+	}
+	// the faults of the previous window are forgotten
+	advance(peerFaultWindow + time.Second)
+	faults, blocked := scores.addFault(id)
+	require.Equal(t, 1, faults)
+	require.False(t, blocked)
+}
+
+func TestPeerScoresBounded(t *testing.T) {
+	scores, advance := newTestPeerScores()
+
+	for i := 0; i < maxTrackedPeers+10; i++ {
+		scores.addFault(libp2p_peer.ID(fmt.Sprintf("peer%d", i)))
+	}
+	require.Equal(t, maxTrackedPeers, scores.tracked())
+
+	// expired windows are pruned to make room for new peers
+	advance(peerFaultWindow + time.Second)
+	faults, _ := scores.addFault(libp2p_peer.ID("new"))
+	require.Equal(t, 1, faults)
+	require.Equal(t, 1, scores.tracked())
+}
@@ -0,0 +1,53 @@
+package p2p
+
+import (
+	"context"
+	"time"
+
+	libp2p_peer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
+)
+
+const (
+	// probeInterval is the interval between two RTT probes of the connected peers
+	probeInterval = time.Minute
+	// probeTimeout is how long to wait for a pong before giving up
+	probeTimeout = 10 * time.Second
+)
+
+// probePeers pings every connected peer each probeInterval until the host is
+// closed. ping.Ping records each RTT in the peerstore, which keeps a moving
+// average of it per peer.
+func (host *HostV2) probePeers() {
+	ticker := time.NewTicker(probeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-host.ctx.Done():
+			return
+		case <-ticker.C:
+			for _, id := range host.h.Network().Peers() {
+				go host.probePeer(id)
+			}
+		}
+	}
+}
+
+func (host *HostV2) probePeer(id libp2p_peer.ID) {
+	ctx, cancel := context.WithTimeout(host.ctx, probeTimeout)
+	defer cancel()
+	if res := <-ping.Ping(ctx, host.h, id); res.Error != nil {
+		host.logger.Debug().Err(res.Error).Str("peer", id.String()).Msg("ping failed")
+	}
+}
+
+// ListPeerLatency returns the average RTT of the connected peers probed so far
+func (host *HostV2) ListPeerLatency() map[libp2p_peer.ID]time.Duration {
+	latencies := make(map[libp2p_peer.ID]time.Duration)
+	for _, id := range host.h.Network().Peers() {
+		if latency := host.h.Peerstore().LatencyEWMA(id); latency > 0 {
+			latencies[id] = latency
+		}
+	}
+	return latencies
+}
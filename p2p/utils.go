@@ -1,6 +1,49 @@
 package p2p
 
-import "sync"
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+// AddrString returns the tcp multiaddr of the given host and port, to dial a
+// peer or to advertise it. The host may be an IPv4 or IPv6 address, with or
+// without brackets and zone, or a DNS name, the latter being resolved by
+// libp2p whenever the address is dialed.
+func AddrString(host, port string) string {
+	ip, zone := parseHostIP(host)
+	switch {
+	case ip == nil:
+		return fmt.Sprintf("/dns/%s/tcp/%s", host, port)
+	case ip.To4() != nil:
+		return fmt.Sprintf("/ip4/%s/tcp/%s", ip, port)
+	case zone != "":
+		return fmt.Sprintf("/ip6zone/%s/ip6/%s/tcp/%s", zone, ip, port)
+	}
+	return fmt.Sprintf("/ip6/%s/tcp/%s", ip, port)
+}
+
+// ListenAddrString returns the tcp multiaddr to listen on the given host and
+// port. Unlike AddrString the host must be an IP address, as libp2p cannot
+// listen on a DNS name.
+func ListenAddrString(host, port string) (string, error) {
+	if ip, _ := parseHostIP(host); ip == nil {
+		return "", fmt.Errorf("cannot listen on %q: not an IP address", host)
+	}
+	return AddrString(host, port), nil
+}
+
+// parseHostIP parses the IP address of host, which may be enclosed in
+// brackets and carry an IPv6 zone. The IP is nil if host is not an address.
+func parseHostIP(host string) (net.IP, string) {
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	zone := ""
+	if i := strings.LastIndexByte(host, '%'); i >= 0 {
+		host, zone = host[:i], host[i+1:]
+	}
+	return net.ParseIP(host), zone
+}
 
 type ConnectCallbacks struct {
 	cbs []ConnectCallback
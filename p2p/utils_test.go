@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	libp2p_network "github.com/libp2p/go-libp2p/core/network"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,3 +36,36 @@ func TestDisConnectCallbacks(t *testing.T) {
 	require.Equal(t, 1, len(cbs.GetAll()))
 	require.Equal(t, reflect.ValueOf(fn).Pointer(), reflect.ValueOf(cbs.GetAll()[0]).Pointer())
 }
+
+func TestAddrString(t *testing.T) {
+	tests := []struct {
+		host string
+		exp  string
+	}{
+		{"127.0.0.1", "/ip4/127.0.0.1/tcp/9000"},
+		{"::1", "/ip6/::1/tcp/9000"},
+		{"[::1]", "/ip6/::1/tcp/9000"},
+		{"fe80::1%eth0", "/ip6zone/eth0/ip6/fe80::1/tcp/9000"},
+		{"[fe80::1%eth0]", "/ip6zone/eth0/ip6/fe80::1/tcp/9000"},
+		{"harmony-0.svc.cluster.local", "/dns/harmony-0.svc.cluster.local/tcp/9000"},
+	}
+	for _, test := range tests {
+		addr := AddrString(test.host, "9000")
+		require.Equal(t, test.exp, addr)
+		_, err := ma.NewMultiaddr(addr)
+		require.NoError(t, err)
+	}
+}
+
+func TestListenAddrString(t *testing.T) {
+	addr, err := ListenAddrString("0.0.0.0", "9000")
+	require.NoError(t, err)
+	require.Equal(t, "/ip4/0.0.0.0/tcp/9000", addr)
+
+	addr, err = ListenAddrString("[::]", "9000")
+	require.NoError(t, err)
+	require.Equal(t, "/ip6/::/tcp/9000", addr)
+
+	_, err = ListenAddrString("harmony-0.svc.cluster.local", "9000")
+	require.Error(t, err)
+}
@@ -49,6 +49,34 @@ type ConsensusInternal struct {
 	ConsensusTime int64  `json:"finality"`
 }
 
+// ConsensusTransition captures one change of the consensus phase or mode
+type ConsensusTransition struct {
+	Time     int64  `json:"time"`
+	Kind     string `json:"kind"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Subject  string `json:"subject,omitempty"`
+	BlockNum uint64 `json:"blocknum"`
+	ViewID   uint64 `json:"viewId"`
+}
+
+// LeaderEquivocation captures two conflicting announces of the same leader
+type LeaderEquivocation struct {
+	Time      int64            `json:"time"`
+	BlockNum  uint64           `json:"blocknum"`
+	ViewID    uint64           `json:"viewId"`
+	LeaderKey string           `json:"leaderKey"`
+	Announces []SignedAnnounce `json:"announces"`
+}
+
+// SignedAnnounce is an announce message as signed by the leader. The
+// signature is over the keccak256 hash of the message.
+type SignedAnnounce struct {
+	BlockHash string `json:"blockHash"`
+	Message   string `json:"message"`
+	Signature string `json:"signature"`
+}
+
 // NodeMetadata captures select metadata of the RPC answering node
 type NodeMetadata struct {
 	BLSPublicKey    []string           `json:"blskey"`
@@ -79,9 +107,11 @@ type P struct {
 
 // NodePeerInfo captures the peer connectivity info of the node
 type NodePeerInfo struct {
-	PeerID       peer.ID   `json:"peerid"`
-	BlockedPeers []peer.ID `json:"blocked-peers"`
-	P            []P       `json:"connected-peers"`
+	PeerID       peer.ID           `json:"peerid"`
+	BlockedPeers []peer.ID         `json:"blocked-peers"`
+	P            []P               `json:"connected-peers"`
+	Latency      map[peer.ID]int64 `json:"peer-latency-ms"`
+	Faults       map[peer.ID]int   `json:"peer-faults"`
 }
 
 type Config struct {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/harmony-one/harmony/eth/rpc"
 	"github.com/harmony-one/harmony/hmy"
+	rpc_common "github.com/harmony-one/harmony/rpc/common"
 )
 
 // PrivateDebugService Internal JSON RPC for debugging purpose
@@ -52,6 +53,20 @@ func (s *PrivateDebugService) GetConsensusPhase(
 	return s.hmy.NodeAPI.GetConsensusPhase()
 }
 
+// GetConsensusTransitions return the latest consensus phase and mode transitions
+func (s *PrivateDebugService) GetConsensusTransitions(
+	ctx context.Context,
+) []rpc_common.ConsensusTransition {
+	return s.hmy.NodeAPI.GetConsensusTransitions()
+}
+
+// GetLeaderEquivocations return the latest conflicting announces of the leaders
+func (s *PrivateDebugService) GetLeaderEquivocations(
+	ctx context.Context,
+) []rpc_common.LeaderEquivocation {
+	return s.hmy.NodeAPI.GetLeaderEquivocations()
+}
+
 // GetConfig get harmony config
 func (s *PrivateDebugService) GetConfig(
 	ctx context.Context,